@@ -0,0 +1,405 @@
+/*
+Package avltree provides a generic, thread-safe AVL tree ordered map in Go.
+
+An AVL tree keeps the heights of the two subtrees of every node within one of each
+other. It is more strictly balanced than a red-black tree, at most about 1.44 log2 n
+deep instead of 2 log2 n, so lookups visit fewer nodes at the price of more rotations
+on updates. That makes it a good fit for read-dominated workloads.
+
+Key Features:
+  - Put / Get / Remove / Contains: Sorted map operations.
+  - Min / Max / Floor / Ceiling: Order queries.
+  - Range / All: Range-over-func iteration in key order.
+  - Height: Height of the tree, for inspecting the balance.
+  - Implements orderedmap.OrderedMap.
+
+Example:
+
+	t := avltree.New[string, int]()
+	t.Put("b", 2)
+	t.Put("a", 1)
+	t.Put("c", 3)
+	t.Ceiling("bb") // "c", 3, true
+	for k, v := range t.All() {
+	    fmt.Println(k, v) // a 1, b 2, c 3
+	}
+*/
+package avltree
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/Zubayear/ryushin/orderedmap"
+	"golang.org/x/exp/constraints"
+)
+
+var _ orderedmap.OrderedMap[int, int] = (*AVLTree[int, int])(nil)
+
+// node is a node of the AVL tree. height is the number of nodes on the longest path
+// from the node down to a leaf.
+type node[K constraints.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	height      int
+}
+
+// AVLTree is a generic sorted map backed by an AVL tree. It is safe for concurrent use.
+// The zero value is not usable; create trees with New.
+type AVLTree[K constraints.Ordered, V any] struct {
+	lockObj sync.RWMutex
+	root    *node[K, V]
+	size    int
+}
+
+// New creates and returns a new, empty AVLTree.
+//
+// Time Complexity: O(1)
+func New[K constraints.Ordered, V any]() *AVLTree[K, V] {
+	return &AVLTree[K, V]{}
+}
+
+// Put associates value with key. Returns true if key was newly added, false if an
+// existing value was replaced.
+// Algorithm: Binary search tree insertion, rebalancing every node on the way back up.
+// Lock acquired for writing.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Put(key K, value V) bool {
+	t.lockObj.Lock()
+	defer t.lockObj.Unlock()
+	var added bool
+	t.root = insert(t.root, key, value, &added)
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// Get returns the value associated with key. Returns false if key is not present.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Get(key K) (V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	for h := t.root; h != nil; {
+		switch {
+		case key < h.key:
+			h = h.left
+		case h.key < key:
+			h = h.right
+		default:
+			return h.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is present.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Remove deletes key and its value. Returns true if key was present, false otherwise.
+// Algorithm: Binary search tree deletion, replacing a node with two children by its
+// successor, then rebalancing every node on the way back up. Lock acquired for writing.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Remove(key K) bool {
+	t.lockObj.Lock()
+	defer t.lockObj.Unlock()
+	var removed bool
+	t.root = remove(t.root, key, &removed)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Len returns the number of keys in the tree.
+//
+// Time Complexity: O(1)
+func (t *AVLTree[K, V]) Len() int {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	return t.size
+}
+
+// Height returns the height of the tree: 0 when empty, 1 for a single key.
+//
+// Time Complexity: O(1)
+func (t *AVLTree[K, V]) Height() int {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	return height(t.root)
+}
+
+// Clear removes all keys from the tree.
+//
+// Time Complexity: O(1)
+func (t *AVLTree[K, V]) Clear() {
+	t.lockObj.Lock()
+	defer t.lockObj.Unlock()
+	t.root = nil
+	t.size = 0
+}
+
+// Min returns the smallest key and its value. Returns false if the tree is empty.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Min() (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	if t.root == nil {
+		return zeroPair[K, V]()
+	}
+	h := minNode(t.root)
+	return h.key, h.value, true
+}
+
+// Max returns the largest key and its value. Returns false if the tree is empty.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Max() (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	h := t.root
+	if h == nil {
+		return zeroPair[K, V]()
+	}
+	for h.right != nil {
+		h = h.right
+	}
+	return h.key, h.value, true
+}
+
+// Floor returns the largest key less than or equal to key, and its value.
+// Returns false if every key is greater than key.
+// Algorithm: Binary search remembering the last node at or below key.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Floor(key K) (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	var found *node[K, V]
+	for h := t.root; h != nil; {
+		if key < h.key {
+			h = h.left
+		} else {
+			found = h
+			h = h.right
+		}
+	}
+	if found == nil {
+		return zeroPair[K, V]()
+	}
+	return found.key, found.value, true
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value.
+// Returns false if every key is less than key.
+// Algorithm: Binary search remembering the last node at or above key.
+//
+// Time Complexity: O(log n)
+func (t *AVLTree[K, V]) Ceiling(key K) (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	var found *node[K, V]
+	for h := t.root; h != nil; {
+		if h.key < key {
+			h = h.right
+		} else {
+			found = h
+			h = h.left
+		}
+	}
+	if found == nil {
+		return zeroPair[K, V]()
+	}
+	return found.key, found.value, true
+}
+
+// All returns an iterator over a snapshot of the pairs in ascending key order. No lock
+// is held while the loop body runs, so the body may modify the tree.
+//
+// Time Complexity: O(n), where n = number of keys in the tree
+func (t *AVLTree[K, V]) All() iter.Seq2[K, V] {
+	return t.scan(nil, nil)
+}
+
+// Range is like All, restricted to the keys with from <= key < to.
+//
+// Time Complexity: O(log n + k), where k = number of pairs in the range
+func (t *AVLTree[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return t.scan(&from, &to)
+}
+
+// scan returns an iterator over a snapshot of the pairs between the optional bounds.
+func (t *AVLTree[K, V]) scan(from, to *K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.lockObj.RLock()
+		var snapshot []entry[K, V]
+		ascend(t.root, from, to, func(h *node[K, V]) {
+			snapshot = append(snapshot, entry[K, V]{h.key, h.value})
+		})
+		t.lockObj.RUnlock()
+		for _, e := range snapshot {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// entry is a key-value pair copied out of the tree.
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// ascend calls visit for the nodes of the subtree rooted at h in ascending order,
+// skipping those below *from or at or above *to when the bounds are not nil.
+// The caller must hold the lock.
+func ascend[K constraints.Ordered, V any](h *node[K, V], from, to *K, visit func(*node[K, V])) {
+	if h == nil {
+		return
+	}
+	aboveFrom := from == nil || !(h.key < *from)
+	belowTo := to == nil || h.key < *to
+	if aboveFrom {
+		ascend(h.left, from, to, visit)
+	}
+	if aboveFrom && belowTo {
+		visit(h)
+	}
+	if belowTo {
+		ascend(h.right, from, to, visit)
+	}
+}
+
+// insert adds or replaces key in the subtree rooted at h and returns the new subtree
+// root, setting *added if key was not present.
+func insert[K constraints.Ordered, V any](h *node[K, V], key K, value V, added *bool) *node[K, V] {
+	if h == nil {
+		*added = true
+		return &node[K, V]{key: key, value: value, height: 1}
+	}
+	switch {
+	case key < h.key:
+		h.left = insert(h.left, key, value, added)
+	case h.key < key:
+		h.right = insert(h.right, key, value, added)
+	default:
+		h.value = value
+		return h
+	}
+	return balance(h)
+}
+
+// remove deletes key from the subtree rooted at h and returns the new subtree root,
+// setting *removed if key was present.
+func remove[K constraints.Ordered, V any](h *node[K, V], key K, removed *bool) *node[K, V] {
+	if h == nil {
+		return nil
+	}
+	switch {
+	case key < h.key:
+		h.left = remove(h.left, key, removed)
+	case h.key < key:
+		h.right = remove(h.right, key, removed)
+	default:
+		*removed = true
+		if h.left == nil {
+			return h.right
+		}
+		if h.right == nil {
+			return h.left
+		}
+		succ := minNode(h.right)
+		h.key, h.value = succ.key, succ.value
+		h.right = removeMin(h.right)
+	}
+	return balance(h)
+}
+
+// removeMin deletes the smallest node of the subtree rooted at h and returns the new
+// subtree root.
+func removeMin[K constraints.Ordered, V any](h *node[K, V]) *node[K, V] {
+	if h.left == nil {
+		return h.right
+	}
+	h.left = removeMin(h.left)
+	return balance(h)
+}
+
+// minNode returns the leftmost node of the subtree rooted at h, which must not be nil.
+func minNode[K constraints.Ordered, V any](h *node[K, V]) *node[K, V] {
+	for h.left != nil {
+		h = h.left
+	}
+	return h
+}
+
+// height returns the height of h; nil subtrees have height 0.
+func height[K constraints.Ordered, V any](h *node[K, V]) int {
+	if h == nil {
+		return 0
+	}
+	return h.height
+}
+
+// update recomputes the height of h from its children.
+func update[K constraints.Ordered, V any](h *node[K, V]) {
+	h.height = 1 + max(height(h.left), height(h.right))
+}
+
+// rotateLeft lifts h.right above h.
+func rotateLeft[K constraints.Ordered, V any](h *node[K, V]) *node[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	update(h)
+	update(x)
+	return x
+}
+
+// rotateRight lifts h.left above h.
+func rotateRight[K constraints.Ordered, V any](h *node[K, V]) *node[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	update(x.right)
+	update(x)
+	return x
+}
+
+// balance restores the AVL invariant at h, whose subtrees are balanced and differ in
+// height by at most 2, and returns the new subtree root.
+func balance[K constraints.Ordered, V any](h *node[K, V]) *node[K, V] {
+	update(h)
+	switch bf := height(h.left) - height(h.right); {
+	case bf > 1:
+		if height(h.left.left) < height(h.left.right) {
+			h.left = rotateLeft(h.left)
+		}
+		return rotateRight(h)
+	case bf < -1:
+		if height(h.right.right) < height(h.right.left) {
+			h.right = rotateRight(h.right)
+		}
+		return rotateLeft(h)
+	}
+	return h
+}
+
+// zeroPair returns zero values and false for failed lookups.
+func zeroPair[K constraints.Ordered, V any]() (K, V, bool) {
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
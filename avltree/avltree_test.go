@@ -0,0 +1,96 @@
+package avltree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkAVL verifies the ordering, the stored heights and the balance of every node and
+// returns the keys in order.
+func checkAVL[V any](t *testing.T, tr *AVLTree[int, V]) []int {
+	t.Helper()
+	var keys []int
+	var walk func(h *node[int, V]) int
+	walk = func(h *node[int, V]) int {
+		if h == nil {
+			return 0
+		}
+		lh := walk(h.left)
+		keys = append(keys, h.key)
+		rh := walk(h.right)
+		if lh-rh > 1 || rh-lh > 1 {
+			t.Fatalf("unbalanced at %v: %d vs %d", h.key, lh, rh)
+		}
+		if h.height != 1+max(lh, rh) {
+			t.Fatalf("stale height at %v", h.key)
+		}
+		return h.height
+	}
+	walk(tr.root)
+	if !slices.IsSorted(keys) || len(keys) != tr.size {
+		t.Fatalf("expected %d sorted keys, got %v", tr.size, keys)
+	}
+	return keys
+}
+
+func TestAVLTreeBasic(t *testing.T) {
+	tr := New[int, string]()
+	if _, _, ok := tr.Min(); ok || tr.Height() != 0 {
+		t.Errorf("Expected empty tree")
+	}
+	for i := 1; i <= 7; i++ {
+		tr.Put(i, "v")
+	}
+	// sorted insertion must not degenerate into a list
+	if tr.Height() != 3 {
+		t.Errorf("Expected height 3 after 7 sorted inserts, got %d", tr.Height())
+	}
+	if tr.Put(4, "four") {
+		t.Errorf("Put(4) twice: expected false")
+	}
+	if v, ok := tr.Get(4); !ok || v != "four" || tr.Contains(8) {
+		t.Errorf("Get(4) = %q, %v; want four", v, ok)
+	}
+	checkAVL(t, tr)
+	if !tr.Remove(4) || tr.Remove(4) || tr.Len() != 6 {
+		t.Errorf("Remove: expected true once")
+	}
+	checkAVL(t, tr)
+	tr.Clear()
+	if tr.Len() != 0 || tr.Contains(1) {
+		t.Errorf("Expected empty tree after Clear")
+	}
+}
+
+func TestAVLTreeRandomizedAgainstMap(t *testing.T) {
+	tr := New[int, int]()
+	ref := make(map[int]int)
+	r := rand.New(rand.NewPCG(7, 8))
+	for i := 0; i < 20000; i++ {
+		k := r.IntN(1000)
+		_, had := ref[k]
+		if r.IntN(2) == 0 {
+			if got := tr.Remove(k); got != had {
+				t.Fatalf("Remove(%d) = %v; want %v", k, got, had)
+			}
+			delete(ref, k)
+		} else {
+			if got := tr.Put(k, i); got == had {
+				t.Fatalf("Put(%d) = %v; want %v", k, got, !had)
+			}
+			ref[k] = i
+		}
+		if i%500 == 0 {
+			checkAVL(t, tr)
+		}
+	}
+	if keys := checkAVL(t, tr); len(keys) != len(ref) {
+		t.Fatalf("%d keys; want %d", len(keys), len(ref))
+	}
+	for k, want := range ref {
+		if v, ok := tr.Get(k); !ok || v != want {
+			t.Fatalf("Get(%d) = %v, %v; want %v", k, v, ok, want)
+		}
+	}
+}
@@ -0,0 +1,491 @@
+/*
+Package btree provides a generic, thread-safe B-tree ordered map in Go.
+
+A B-tree keeps many keys per node in a contiguous slice, so a lookup touches only
+O(log_t n) nodes and scans each of them with a cache-friendly binary search. Compared to
+a binary search tree it allocates far fewer nodes, which keeps large maps fast and cheap
+for the garbage collector.
+
+Key Features:
+  - Put / Get / Remove / Contains: Sorted map operations.
+  - Min / Max / Floor / Ceiling: Order queries.
+  - Range / All: Range-over-func iteration in key order.
+  - NewWithDegree: Tune the node size for the workload.
+  - Implements orderedmap.OrderedMap.
+
+Example:
+
+	t := btree.New[int, string]()
+	t.Put(30, "c")
+	t.Put(10, "a")
+	t.Put(20, "b")
+	t.Floor(25) // 20, "b", true
+	for k, v := range t.Range(10, 30) {
+	    fmt.Println(k, v) // 10 a, 20 b
+	}
+*/
+package btree
+
+import (
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/Zubayear/ryushin/orderedmap"
+	"golang.org/x/exp/constraints"
+)
+
+var _ orderedmap.OrderedMap[int, int] = (*BTree[int, int])(nil)
+
+// DefaultDegree is the minimum degree used by New and by NewWithDegree when given a
+// degree below 2. Nodes then hold between 31 and 63 keys.
+const DefaultDegree = 32
+
+// item is a key-value pair stored in a node.
+type item[K constraints.Ordered, V any] struct {
+	key   K
+	value V
+}
+
+// node is a B-tree node. Leaves have no children; an inner node with n items has n+1
+// children, and children[i] holds the keys between items[i-1] and items[i].
+type node[K constraints.Ordered, V any] struct {
+	items    []item[K, V]
+	children []*node[K, V]
+}
+
+// BTree is a generic sorted map backed by a B-tree. It is safe for concurrent use.
+// The zero value is not usable; create trees with New or NewWithDegree.
+//
+// Every node except the root holds between degree-1 and 2*degree-1 items.
+type BTree[K constraints.Ordered, V any] struct {
+	lockObj sync.RWMutex
+	root    *node[K, V]
+	degree  int
+	size    int
+}
+
+// New creates and returns a new, empty BTree with DefaultDegree.
+//
+// Time Complexity: O(1)
+func New[K constraints.Ordered, V any]() *BTree[K, V] {
+	return NewWithDegree[K, V](DefaultDegree)
+}
+
+// NewWithDegree creates and returns a new, empty BTree with the given minimum degree.
+// A degree below 2 means DefaultDegree. Small degrees make updates cheaper; large
+// degrees make the tree shallower and scans faster.
+//
+// Time Complexity: O(1)
+func NewWithDegree[K constraints.Ordered, V any](degree int) *BTree[K, V] {
+	if degree < 2 {
+		degree = DefaultDegree
+	}
+	return &BTree[K, V]{degree: degree}
+}
+
+// search returns the index of the first item whose key is not less than key, and
+// whether that item holds key.
+func (n *node[K, V]) search(key K) (int, bool) {
+	lo, hi := 0, len(n.items)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if n.items[mid].key < key {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(n.items) && n.items[lo].key == key
+}
+
+// leaf reports whether n has no children.
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// Put associates value with key. Returns true if key was newly added, false if an
+// existing value was replaced.
+// Algorithm: Single-pass insertion; full nodes are split on the way down so the leaf
+// that receives the key always has room. Lock acquired for writing.
+//
+// Time Complexity: O(t log_t n), where t = degree
+func (t *BTree[K, V]) Put(key K, value V) bool {
+	t.lockObj.Lock()
+	defer t.lockObj.Unlock()
+	if t.root == nil {
+		t.root = &node[K, V]{items: []item[K, V]{{key, value}}}
+		t.size++
+		return true
+	}
+	if len(t.root.items) == 2*t.degree-1 {
+		t.root = &node[K, V]{children: []*node[K, V]{t.root}}
+		t.split(t.root, 0)
+	}
+	added := t.insert(t.root, item[K, V]{key, value})
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// insert adds it to the subtree rooted at n, which must not be full, and reports
+// whether the key was new. The caller must hold the lock.
+func (t *BTree[K, V]) insert(n *node[K, V], it item[K, V]) bool {
+	for {
+		i, found := n.search(it.key)
+		if found {
+			n.items[i].value = it.value
+			return false
+		}
+		if n.leaf() {
+			n.items = slices.Insert(n.items, i, it)
+			return true
+		}
+		if len(n.children[i].items) == 2*t.degree-1 {
+			t.split(n, i)
+			switch {
+			case n.items[i].key == it.key:
+				n.items[i].value = it.value
+				return false
+			case n.items[i].key < it.key:
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// split moves the upper half of the full child n.children[i] into a new sibling and
+// lifts its median item into n. The caller must hold the lock.
+func (t *BTree[K, V]) split(n *node[K, V], i int) {
+	c := n.children[i]
+	mid := t.degree - 1
+	right := &node[K, V]{items: slices.Clone(c.items[mid+1:])}
+	median := c.items[mid]
+	clear(c.items[mid:])
+	c.items = c.items[:mid]
+	if !c.leaf() {
+		right.children = slices.Clone(c.children[mid+1:])
+		clear(c.children[mid+1:])
+		c.children = c.children[:mid+1]
+	}
+	n.items = slices.Insert(n.items, i, median)
+	n.children = slices.Insert(n.children, i+1, right)
+}
+
+// Get returns the value associated with key. Returns false if key is not present.
+//
+// Time Complexity: O(log n)
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	for n := t.root; n != nil; {
+		i, found := n.search(key)
+		if found {
+			return n.items[i].value, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is present.
+//
+// Time Complexity: O(log n)
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Remove deletes key and its value. Returns true if key was present, false otherwise.
+// Algorithm: Single-pass deletion; before descending into a child with the minimum
+// number of items, borrow an item from a sibling or merge with it, so the key can be
+// removed without walking back up. Lock acquired for writing.
+//
+// Time Complexity: O(t log_t n), where t = degree
+func (t *BTree[K, V]) Remove(key K) bool {
+	t.lockObj.Lock()
+	defer t.lockObj.Unlock()
+	if t.root == nil {
+		return false
+	}
+	removed := t.delete(t.root, key)
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// delete removes key from the subtree rooted at n, which must hold at least degree
+// items unless it is the root, and reports whether it was present.
+// The caller must hold the lock.
+func (t *BTree[K, V]) delete(n *node[K, V], key K) bool {
+	for {
+		i, found := n.search(key)
+		if n.leaf() {
+			if found {
+				n.items = slices.Delete(n.items, i, i+1)
+			}
+			return found
+		}
+		if found {
+			switch {
+			case len(n.children[i].items) >= t.degree:
+				// replace the key with its predecessor and delete that instead
+				pred := n.children[i]
+				for !pred.leaf() {
+					pred = pred.children[len(pred.children)-1]
+				}
+				n.items[i] = pred.items[len(pred.items)-1]
+				key = n.items[i].key
+				n = n.children[i]
+			case len(n.children[i+1].items) >= t.degree:
+				succ := n.children[i+1]
+				for !succ.leaf() {
+					succ = succ.children[0]
+				}
+				n.items[i] = succ.items[0]
+				key = n.items[i].key
+				n = n.children[i+1]
+			default:
+				t.merge(n, i)
+				n = n.children[i]
+			}
+			continue
+		}
+		if len(n.children[i].items) < t.degree {
+			i = t.fill(n, i)
+		}
+		n = n.children[i]
+	}
+}
+
+// fill gives n.children[i], which holds degree-1 items, an extra item by borrowing
+// from a sibling or merging with one, and returns the index of the child that now
+// covers the same keys. The caller must hold the lock.
+func (t *BTree[K, V]) fill(n *node[K, V], i int) int {
+	c := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].items) >= t.degree:
+		// rotate right: the separator moves down, the left sibling's last item up
+		left := n.children[i-1]
+		c.items = slices.Insert(c.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items = slices.Delete(left.items, len(left.items)-1, len(left.items))
+		if !left.leaf() {
+			c.children = slices.Insert(c.children, 0, left.children[len(left.children)-1])
+			left.children = slices.Delete(left.children, len(left.children)-1, len(left.children))
+		}
+		return i
+	case i < len(n.items) && len(n.children[i+1].items) >= t.degree:
+		// rotate left: the separator moves down, the right sibling's first item up
+		right := n.children[i+1]
+		c.items = append(c.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+		if !right.leaf() {
+			c.children = append(c.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+		return i
+	case i < len(n.items):
+		t.merge(n, i)
+		return i
+	default:
+		t.merge(n, i-1)
+		return i - 1
+	}
+}
+
+// merge joins n.children[i], the separator n.items[i] and n.children[i+1] into a
+// single child. The caller must hold the lock.
+func (t *BTree[K, V]) merge(n *node[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.items = append(append(left.items, n.items[i]), right.items...)
+	left.children = append(left.children, right.children...)
+	n.items = slices.Delete(n.items, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// Len returns the number of keys in the tree.
+//
+// Time Complexity: O(1)
+func (t *BTree[K, V]) Len() int {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	return t.size
+}
+
+// Clear removes all keys from the tree.
+//
+// Time Complexity: O(1)
+func (t *BTree[K, V]) Clear() {
+	t.lockObj.Lock()
+	defer t.lockObj.Unlock()
+	t.root = nil
+	t.size = 0
+}
+
+// Min returns the smallest key and its value. Returns false if the tree is empty.
+//
+// Time Complexity: O(log n)
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	if t.root == nil {
+		return zeroItem[K, V]()
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.items[0].key, n.items[0].value, true
+}
+
+// Max returns the largest key and its value. Returns false if the tree is empty.
+//
+// Time Complexity: O(log n)
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	if t.root == nil {
+		return zeroItem[K, V]()
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	it := n.items[len(n.items)-1]
+	return it.key, it.value, true
+}
+
+// Floor returns the largest key less than or equal to key, and its value.
+// Returns false if every key is greater than key.
+// Algorithm: Descend towards key, remembering the last item below it.
+//
+// Time Complexity: O(log n)
+func (t *BTree[K, V]) Floor(key K) (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	var found *item[K, V]
+	for n := t.root; n != nil; {
+		i, ok := n.search(key)
+		if ok {
+			return n.items[i].key, n.items[i].value, true
+		}
+		if i > 0 {
+			found = &n.items[i-1]
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	if found == nil {
+		return zeroItem[K, V]()
+	}
+	return found.key, found.value, true
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value.
+// Returns false if every key is less than key.
+// Algorithm: Descend towards key, remembering the last item above it.
+//
+// Time Complexity: O(log n)
+func (t *BTree[K, V]) Ceiling(key K) (K, V, bool) {
+	t.lockObj.RLock()
+	defer t.lockObj.RUnlock()
+	var found *item[K, V]
+	for n := t.root; n != nil; {
+		i, ok := n.search(key)
+		if ok {
+			return n.items[i].key, n.items[i].value, true
+		}
+		if i < len(n.items) {
+			found = &n.items[i]
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	if found == nil {
+		return zeroItem[K, V]()
+	}
+	return found.key, found.value, true
+}
+
+// All returns an iterator over a snapshot of the pairs in ascending key order. No lock
+// is held while the loop body runs, so the body may modify the tree.
+//
+// Time Complexity: O(n), where n = number of keys in the tree
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return t.scan(nil, nil)
+}
+
+// Range is like All, restricted to the keys with from <= key < to.
+//
+// Time Complexity: O(log n + k), where k = number of pairs in the range
+func (t *BTree[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return t.scan(&from, &to)
+}
+
+// scan returns an iterator over a snapshot of the pairs between the optional bounds.
+func (t *BTree[K, V]) scan(from, to *K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.lockObj.RLock()
+		var snapshot []item[K, V]
+		if t.root != nil {
+			ascend(t.root, from, to, func(it item[K, V]) { snapshot = append(snapshot, it) })
+		}
+		t.lockObj.RUnlock()
+		for _, it := range snapshot {
+			if !yield(it.key, it.value) {
+				return
+			}
+		}
+	}
+}
+
+// ascend calls visit for the items of the subtree rooted at n in ascending order,
+// skipping those below *from and stopping at the first one at or above *to when the
+// bounds are not nil. Returns false once the upper bound is reached.
+// The caller must hold the lock.
+func ascend[K constraints.Ordered, V any](n *node[K, V], from, to *K, visit func(item[K, V])) bool {
+	start := 0
+	if from != nil {
+		start, _ = n.search(*from)
+	}
+	for i := start; i < len(n.items); i++ {
+		if !n.leaf() && !ascend(n.children[i], from, to, visit) {
+			return false
+		}
+		if to != nil && !(n.items[i].key < *to) {
+			return false
+		}
+		visit(n.items[i])
+	}
+	if !n.leaf() {
+		return ascend(n.children[len(n.items)], from, to, visit)
+	}
+	return true
+}
+
+// zeroItem returns zero values and false for failed lookups.
+func zeroItem[K constraints.Ordered, V any]() (K, V, bool) {
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
@@ -0,0 +1,22 @@
+package btree
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// Put and Get against the other OrderedMap implementations are benchmarked in the
+// orderedmap package; this compares degrees.
+func BenchmarkBTree_PutByDegree(b *testing.B) {
+	keys := rand.New(rand.NewPCG(1, 1)).Perm(1 << 16)
+	for _, degree := range []int{2, 8, DefaultDegree, 128} {
+		b.Run(fmt.Sprintf("degree=%d", degree), func(b *testing.B) {
+			bt := NewWithDegree[int, int](degree)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bt.Put(keys[i%len(keys)], i)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package btree
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkBTree verifies the node sizes, the key order and that all leaves are at the
+// same depth, and returns the keys in order.
+func checkBTree[V any](t *testing.T, bt *BTree[int, V]) []int {
+	t.Helper()
+	var keys []int
+	leafDepth := -1
+	var walk func(n *node[int, V], depth int)
+	walk = func(n *node[int, V], depth int) {
+		if n != bt.root && (len(n.items) < bt.degree-1 || len(n.items) > 2*bt.degree-1) {
+			t.Fatalf("node with %d items at depth %d", len(n.items), depth)
+		}
+		if n.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				t.Fatalf("leaves at depths %d and %d", leafDepth, depth)
+			}
+		} else if len(n.children) != len(n.items)+1 {
+			t.Fatalf("node with %d items has %d children", len(n.items), len(n.children))
+		}
+		for i, it := range n.items {
+			if !n.leaf() {
+				walk(n.children[i], depth+1)
+			}
+			keys = append(keys, it.key)
+		}
+		if !n.leaf() {
+			walk(n.children[len(n.items)], depth+1)
+		}
+	}
+	if bt.root != nil {
+		walk(bt.root, 0)
+	}
+	if !slices.IsSorted(keys) || len(keys) != bt.size {
+		t.Fatalf("expected %d sorted keys, got %v", bt.size, keys)
+	}
+	return keys
+}
+
+func TestBTreeBasic(t *testing.T) {
+	bt := NewWithDegree[int, string](2)
+	if _, _, ok := bt.Min(); ok {
+		t.Errorf("Min: expected false on empty tree")
+	}
+	if _, _, ok := bt.Floor(1); ok {
+		t.Errorf("Floor: expected false on empty tree")
+	}
+	for _, k := range []int{50, 20, 80, 10, 30, 60, 90, 40, 70} {
+		if !bt.Put(k, "v") {
+			t.Errorf("Put(%d): expected true", k)
+		}
+	}
+	if bt.Put(30, "thirty") {
+		t.Errorf("Put(30) twice: expected false")
+	}
+	if v, ok := bt.Get(30); !ok || v != "thirty" || bt.Contains(35) {
+		t.Errorf("Get(30) = %q, %v; want thirty", v, ok)
+	}
+	if got := checkBTree(t, bt); !reflect.DeepEqual(got, []int{10, 20, 30, 40, 50, 60, 70, 80, 90}) {
+		t.Errorf("Keys = %v", got)
+	}
+	if k, _, _ := bt.Min(); k != 10 {
+		t.Errorf("Min = %d; want 10", k)
+	}
+	if k, _, _ := bt.Max(); k != 90 {
+		t.Errorf("Max = %d; want 90", k)
+	}
+
+	tests := []struct {
+		key                  int
+		floor, ceil          int
+		hasFloor, hasCeiling bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{45, 40, 50, true, true},
+		{90, 90, 90, true, true},
+		{95, 90, 0, true, false},
+	}
+	for _, tt := range tests {
+		if k, _, ok := bt.Floor(tt.key); k != tt.floor || ok != tt.hasFloor {
+			t.Errorf("Floor(%d) = %v, %v; want %v, %v", tt.key, k, ok, tt.floor, tt.hasFloor)
+		}
+		if k, _, ok := bt.Ceiling(tt.key); k != tt.ceil || ok != tt.hasCeiling {
+			t.Errorf("Ceiling(%d) = %v, %v; want %v, %v", tt.key, k, ok, tt.ceil, tt.hasCeiling)
+		}
+	}
+
+	var keys []int
+	for k := range bt.Range(25, 70) {
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{30, 40, 50, 60}) {
+		t.Errorf("Range(25, 70) = %v; want [30 40 50 60]", keys)
+	}
+	keys = keys[:0]
+	for k := range bt.All() {
+		if k == 30 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{10, 20}) {
+		t.Errorf("All with early exit = %v; want [10 20]", keys)
+	}
+
+	if !bt.Remove(50) || bt.Remove(50) || bt.Len() != 8 {
+		t.Errorf("Remove: expected true once")
+	}
+	checkBTree(t, bt)
+	bt.Clear()
+	if bt.Len() != 0 || bt.Contains(10) || bt.Remove(10) {
+		t.Errorf("Expected empty tree after Clear")
+	}
+}
+
+func TestBTreeRandomizedAgainstMap(t *testing.T) {
+	for _, degree := range []int{2, 3, 8} {
+		bt := NewWithDegree[int, int](degree)
+		ref := make(map[int]int)
+		r := rand.New(rand.NewPCG(uint64(degree), 5))
+		for i := 0; i < 20000; i++ {
+			k := r.IntN(1000)
+			_, had := ref[k]
+			if r.IntN(2) == 0 {
+				if got := bt.Remove(k); got != had {
+					t.Fatalf("degree %d: Remove(%d) = %v; want %v", degree, k, got, had)
+				}
+				delete(ref, k)
+			} else {
+				if got := bt.Put(k, i); got == had {
+					t.Fatalf("degree %d: Put(%d) = %v; want %v", degree, k, got, !had)
+				}
+				ref[k] = i
+			}
+			if i%500 == 0 {
+				checkBTree(t, bt)
+			}
+		}
+		keys := checkBTree(t, bt)
+		if len(keys) != len(ref) {
+			t.Fatalf("degree %d: %d keys; want %d", degree, len(keys), len(ref))
+		}
+		for k, want := range ref {
+			if v, ok := bt.Get(k); !ok || v != want {
+				t.Fatalf("degree %d: Get(%d) = %v, %v; want %v", degree, k, v, ok, want)
+			}
+		}
+		for k := range ref {
+			bt.Remove(k)
+		}
+		if bt.Len() != 0 || bt.root != nil {
+			t.Fatalf("degree %d: expected empty tree", degree)
+		}
+	}
+}
+
+func TestBTreeDefaultDegree(t *testing.T) {
+	if bt := NewWithDegree[int, int](0); bt.degree != DefaultDegree {
+		t.Errorf("Expected DefaultDegree, got %d", bt.degree)
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Package cache provides generic, thread-safe in-memory caches in Go.
+
+LRU extends linkedlist.LRUCache, which handles recency and capacity eviction, with
+per-entry expiry and hit/miss statistics.
+
+Key Features:
+  - Get / Peek / Put / Remove: Cache operations; Peek does not refresh recency.
+  - PutWithTTL: Store an entry that expires after a duration.
+  - RemoveExpired: Drop every expired entry at once.
+  - Eviction callback: Called for entries evicted for capacity or expired.
+  - Stats: Hit, miss, eviction and expiration counters.
+
+Concurrency:
+  - All methods are safe for concurrent use.
+  - The eviction callback runs after the lock is released, so it may use the cache.
+
+Example:
+
+	c := cache.NewLRU[string, int](2, func(k string, v int) {
+	    fmt.Println("evicted", k)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")                        // "a" becomes the most recently used entry
+	c.Put("c", 3)                     // prints "evicted b"
+	c.PutWithTTL("d", 4, time.Minute) // prints "evicted a"
+	c.Stats()                         // {Hits:1 Misses:0 Evictions:2 Expirations:0}
+*/
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Zubayear/ryushin/linkedlist"
+)
+
+// entry is a cached value with its expiry time.
+type entry[V any] struct {
+	value   V
+	expires time.Time // zero if the entry never expires
+}
+
+// expired reports whether e has expired at now.
+func (e *entry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// evictedEntry is an entry removed by the cache, waiting for the eviction callback.
+type evictedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// Stats holds the counters of an LRU.
+type Stats struct {
+	Hits        uint64 // Get calls that found a live entry
+	Misses      uint64 // Get calls that found no entry or an expired one
+	Evictions   uint64 // entries evicted to make room for a new one
+	Expirations uint64 // expired entries removed
+}
+
+// LRU is a thread-safe, fixed-capacity cache that evicts the least recently used
+// entry when full. Entries may carry a time to live, after which they are treated as
+// absent and removed when next seen.
+//
+// It wraps a linkedlist.LRUCache. Its own mutex makes the expiry checks atomic with
+// the lookups and updates that follow them; the inner cache is only accessed while
+// holding it.
+type LRU[K comparable, V any] struct {
+	mutex   sync.Mutex
+	lru     *linkedlist.LRUCache[K, *entry[V]]
+	onEvict func(K, V)
+	pending []evictedEntry[K, V] // evicted under the lock, reported after it
+	stats   Stats
+	now     func() time.Time
+}
+
+// NewLRU creates an empty LRU holding at most capacity entries.
+//
+// onEvict, if not nil, is called with every entry evicted to make room for a new one
+// and every expired entry the cache removes, but not for entries removed with Remove
+// or Clear, or replaced by Put. A capacity below 1 is treated as 1.
+//
+// Time Complexity: O(1)
+func NewLRU[K comparable, V any](capacity int, onEvict func(K, V)) *LRU[K, V] {
+	c := &LRU[K, V]{onEvict: onEvict, now: time.Now}
+	c.lru = linkedlist.NewLRUCache(capacity, c.evict)
+	return c
+}
+
+// evict records an entry evicted by the inner cache. It runs inside lru.Put, so the
+// caller holds the lock.
+func (c *LRU[K, V]) evict(key K, e *entry[V]) {
+	if e.expired(c.now()) {
+		c.stats.Expirations++
+	} else {
+		c.stats.Evictions++
+	}
+	c.pending = append(c.pending, evictedEntry[K, V]{key, e.value})
+}
+
+// unlock releases the lock and then runs the eviction callback for pending entries.
+func (c *LRU[K, V]) unlock() {
+	pending := c.pending
+	c.pending = nil
+	c.mutex.Unlock()
+	if c.onEvict == nil {
+		return
+	}
+	for _, e := range pending {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// expire removes key, whose entry e has expired, and queues it for the callback.
+// The caller must hold the lock.
+func (c *LRU[K, V]) expire(key K, e *entry[V]) {
+	c.lru.Remove(key)
+	c.stats.Expirations++
+	c.pending = append(c.pending, evictedEntry[K, V]{key, e.value})
+}
+
+// Get returns the value stored for key and marks the entry as most recently used.
+// An expired entry is removed and reported as missing.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.unlock()
+	e, ok := c.lru.Get(key)
+	if ok && e.expired(c.now()) {
+		c.expire(key, e)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	return e.value, true
+}
+
+// Peek returns the value stored for key without updating its recency or the stats.
+// An expired entry is reported as missing.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.lru.Peek(key)
+	if !ok || e.expired(c.now()) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Put stores value for key without expiry and marks the entry as most recently used.
+// If the cache is full and key is new, the least recently used entry is evicted first.
+//
+// Returns true if an entry was evicted.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Put(key K, value V) bool {
+	return c.put(key, &entry[V]{value: value})
+}
+
+// PutWithTTL is like Put, but the entry expires once ttl has elapsed. A ttl of zero or
+// less stores the entry without expiry.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) bool {
+	e := &entry[V]{value: value}
+	if ttl > 0 {
+		e.expires = c.now().Add(ttl)
+	}
+	return c.put(key, e)
+}
+
+// put stores e for key, running the eviction callback after releasing the lock.
+func (c *LRU[K, V]) put(key K, e *entry[V]) bool {
+	c.mutex.Lock()
+	defer c.unlock()
+	return c.lru.Put(key, e)
+}
+
+// Remove deletes the entry for key. The eviction callback is not called.
+//
+// Returns true if the entry existed, even if it had expired.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Remove(key K) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Remove(key)
+}
+
+// RemoveExpired removes every expired entry, calling the eviction callback for each,
+// and returns how many were removed.
+//
+// Time Complexity: O(n)
+func (c *LRU[K, V]) RemoveExpired() int {
+	c.mutex.Lock()
+	defer c.unlock()
+	now := c.now()
+	n := 0
+	for _, key := range c.lru.Keys() {
+		if e, _ := c.lru.Peek(key); e.expired(now) {
+			c.expire(key, e)
+			n++
+		}
+	}
+	return n
+}
+
+// Clear removes all entries. The eviction callback is not called and the stats are
+// kept.
+//
+// Time Complexity: O(n)
+func (c *LRU[K, V]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, key := range c.lru.Keys() {
+		c.lru.Remove(key)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries that have
+// not been removed yet.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Capacity() int {
+	return c.lru.Capacity()
+}
+
+// Keys returns the keys of the live entries from most to least recently used.
+//
+// Time Complexity: O(n)
+func (c *LRU[K, V]) Keys() []K {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := c.now()
+	keys := c.lru.Keys()
+	live := keys[:0]
+	for _, key := range keys {
+		if e, _ := c.lru.Peek(key); !e.expired(now) {
+			live = append(live, key)
+		}
+	}
+	return live
+}
+
+// Stats returns a snapshot of the cache counters.
+//
+// Time Complexity: O(1)
+func (c *LRU[K, V]) Stats() Stats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.stats
+}
@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced clock for expiry tests.
+type fakeClock struct{ t time.Time }
+
+func (f *fakeClock) now() time.Time          { return f.t }
+func (f *fakeClock) advance(d time.Duration) { f.t = f.t.Add(d) }
+
+func TestLRUEvictionAndStats(t *testing.T) {
+	var evicted []string
+	c := NewLRU[string, int](2, func(k string, v int) {
+		evicted = append(evicted, k)
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(a) = %d, %v; want 1, true", v, ok)
+	}
+	if !c.Put("c", 3) {
+		t.Errorf("Expected Put(c) to evict")
+	}
+	if !reflect.DeepEqual(evicted, []string{"b"}) {
+		t.Errorf("evicted = %v; want [b]", evicted)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if got := c.Keys(); !reflect.DeepEqual(got, []string{"c", "a"}) {
+		t.Errorf("Keys() = %v; want [c a]", got)
+	}
+
+	// Peek neither refreshes recency nor counts as a hit
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Errorf("Peek(a) = %d, %v; want 1, true", v, ok)
+	}
+	if c.Put("c", 30) {
+		t.Errorf("Expected update not to evict")
+	}
+	c.Put("d", 4)
+	if !reflect.DeepEqual(evicted, []string{"b", "a"}) {
+		t.Errorf("evicted = %v; want [b a]", evicted)
+	}
+	if want := (Stats{Hits: 1, Misses: 1, Evictions: 2}); c.Stats() != want {
+		t.Errorf("Stats() = %+v; want %+v", c.Stats(), want)
+	}
+
+	if !c.Remove("c") || c.Remove("c") || c.Len() != 1 {
+		t.Errorf("Remove(c) returned unexpected result")
+	}
+	c.Clear()
+	if c.Len() != 0 || len(c.Keys()) != 0 || len(evicted) != 2 {
+		t.Errorf("Expected empty cache after Clear without callbacks")
+	}
+	if NewLRU[int, int](0, nil).Capacity() != 1 {
+		t.Errorf("Expected capacity below 1 to be treated as 1")
+	}
+}
+
+func TestLRUTTL(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	var evicted []string
+	c := NewLRU[string, int](3, func(k string, v int) {
+		evicted = append(evicted, k)
+	})
+	c.now = clock.now
+
+	c.PutWithTTL("short", 1, time.Second)
+	c.PutWithTTL("long", 2, time.Minute)
+	c.Put("forever", 3)
+	c.PutWithTTL("zero", 4, 0) // evicts "short"; a ttl of 0 never expires
+	if !reflect.DeepEqual(evicted, []string{"short"}) {
+		t.Fatalf("evicted = %v; want [short]", evicted)
+	}
+	evicted = nil
+
+	clock.advance(time.Minute)
+	if _, ok := c.Peek("long"); ok {
+		t.Errorf("Peek(long): expected expired entry to be missing")
+	}
+	if got := c.Keys(); !reflect.DeepEqual(got, []string{"zero", "forever"}) {
+		t.Errorf("Keys() = %v; want [zero forever]", got)
+	}
+	if c.Len() != 3 {
+		t.Errorf("Expected the expired entry to stay until removed, got length %d", c.Len())
+	}
+	if _, ok := c.Get("long"); ok {
+		t.Errorf("Get(long): expected expired entry to be missing")
+	}
+	if c.Len() != 2 || !reflect.DeepEqual(evicted, []string{"long"}) {
+		t.Errorf("Expected Get to remove the expired entry, got length %d and evicted %v", c.Len(), evicted)
+	}
+
+	// re-putting an entry resets its expiry
+	c.PutWithTTL("forever", 30, time.Second)
+	clock.advance(time.Second / 2)
+	c.PutWithTTL("forever", 31, time.Second)
+	clock.advance(time.Second / 2)
+	if v, ok := c.Get("forever"); !ok || v != 31 {
+		t.Errorf("Get(forever) = %d, %v; want 31, true", v, ok)
+	}
+	c.PutWithTTL("a", 5, time.Second)
+	clock.advance(time.Second)
+	if n := c.RemoveExpired(); n != 2 || c.Len() != 1 {
+		t.Errorf("RemoveExpired() = %d with length %d; want 2 with length 1", n, c.Len())
+	}
+	if want := (Stats{Hits: 1, Misses: 1, Evictions: 1, Expirations: 3}); c.Stats() != want {
+		t.Errorf("Stats() = %+v; want %+v", c.Stats(), want)
+	}
+}
+
+func TestLRUCallbackMayUseCache(t *testing.T) {
+	var c *LRU[int, int]
+	c = NewLRU[int, int](1, func(k, v int) {
+		c.Peek(k) // must not deadlock
+	})
+	c.Put(1, 1)
+	c.Put(2, 2)
+}
+
+func TestLRUConcurrent(t *testing.T) {
+	c := NewLRU[int, int](64, nil)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Put(g*1000+i, i)
+				c.Get(g*1000 + i/2)
+				c.PutWithTTL(-i, i, time.Hour)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if c.Len() != 64 {
+		t.Errorf("Expected a full cache, got length %d", c.Len())
+	}
+	if s := c.Stats(); s.Hits+s.Misses != 8000 {
+		t.Errorf("Expected 8000 lookups, got %+v", s)
+	}
+}
@@ -11,20 +11,68 @@ Key Features:
   - OfferFirst / OfferLast: Add elements to the front or rear of the deque.
   - PollFirst / PollLast: Remove elements from the front or rear.
   - PeekFirst / PeekLast: Access elements at the front or rear without removal.
+  - PeekAt: Access the element at an index, walking from the closer end.
+  - OfferAllFirst / OfferAllLast / Drain: Batch operations under a single lock acquisition.
+  - Clone / Equal: Copy a deque or compare two deques element by element.
+  - MonotonicDeque: Sliding-window maximum and minimum in amortized O(1), built on Deque.
+  - StripedDeque: A lock-striped variant for multi-producer multi-consumer workloads
+    that trades global ordering for lower contention.
   - Remove: Delete the first occurrence of an element (O(n) operation).
+  - RemoveIf: Delete every element matching a predicate in one locked traversal.
+  - ForEach: Visit the elements from front to rear, stopping early when asked to.
+  - Contains / Clear: Check for an element (O(n) operation) or remove all elements.
   - Size / IsEmpty: Retrieve deque size or check for emptiness.
+  - Push / Pop / Top and Enqueue / Dequeue: Aliases matching the stack and queue
+    packages, so a Deque can stand in for either abstraction.
+  - NewBoundedDeque: A deque with a fixed capacity; offers on a full deque drop the
+    element at the opposite end.
+  - OnEvict: A callback receiving the elements dropped by offers on a full bounded deque.
+  - TakeFirst / TakeLast / PutFirst / PutLast: Blocking operations that wait, until
+    their context is done, for an element or for free capacity.
+
+The zero value of Deque is an empty, unbounded deque ready to use; its backing list
+is created lazily on first use, so `var d deque.Deque[int]` needs no constructor.
 
 Concurrency:
   - All public methods are safe for concurrent use by multiple goroutines.
+  - The deque guards its list with its own mutex; blocking operations wait on
+    sync.Cond conditions tied to that mutex instead of busy-polling.
 */
 package deque
 
-import "github.com/Zubayear/ryushin/linkedlist"
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/Zubayear/ryushin/linkedlist"
+)
+
+// Errors returned by Deque operations. Callers can test for them with errors.Is.
+var (
+	// ErrEmpty is returned when an element is requested from an empty deque or window.
+	ErrEmpty = errors.New("deque empty")
+	// ErrInvalidIndex is returned when an index is outside the deque.
+	ErrInvalidIndex = errors.New("invalid index")
+)
 
 // Deque is a generic double-ended queue backed by a doubly linked structure.
 // It supports adding, removing, and peeking elements from both ends in O(1) time.
+//
+// Fields:
+//   - data: the backing list; it is unsynchronized because mutex guards it
+//   - capacity: the maximum number of elements, 0 for an unbounded deque
+//   - mutex: guards data and the conditions below
+//   - notEmpty: signalled when an element is added
+//   - notFull: signalled when an element is removed
+//   - onEvict: optional callback invoked for every element dropped due to capacity
 type Deque[T comparable] struct {
-	data *linkedlist.DoublyLinkedList[T]
+	data     *linkedlist.DoublyLinkedList[T]
+	capacity int
+	onEvict  func(T)
+	mutex    sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
 }
 
 // NewDeque returns a new, empty Deque[T] backed by a doubly linked list.
@@ -32,80 +80,540 @@ type Deque[T comparable] struct {
 //
 // Time Complexity: O(1)
 func NewDeque[T comparable]() *Deque[T] {
-	return &Deque[T]{
-		data: linkedlist.NewLinkedList[T](),
+	d := &Deque[T]{}
+	d.lazyInit()
+	return d
+}
+
+// lazyInit creates the backing list and the conditions of a zero-value deque.
+// The caller must hold the lock, or own d exclusively.
+func (d *Deque[T]) lazyInit() {
+	if d.data != nil {
+		return
+	}
+	d.data = linkedlist.NewUnsafeLinkedList[T]()
+	d.notEmpty = sync.NewCond(&d.mutex)
+	d.notFull = sync.NewCond(&d.mutex)
+}
+
+// lock acquires the deque's mutex, initializing a zero-value deque on first use.
+func (d *Deque[T]) lock() {
+	d.mutex.Lock()
+	d.lazyInit()
+}
+
+// unlock releases the deque's mutex.
+func (d *Deque[T]) unlock() {
+	d.mutex.Unlock()
+}
+
+// NewBoundedDeque returns a new, empty Deque[T] holding at most capacity elements.
+//
+// When the deque is full, OfferFirst drops the last element and OfferLast drops the
+// first one to make room, while PutFirst and PutLast block until space is available.
+// A capacity below 1 is treated as 1.
+//
+// Time Complexity: O(1)
+func NewBoundedDeque[T comparable](capacity int) *Deque[T] {
+	d := NewDeque[T]()
+	d.capacity = max(capacity, 1)
+	return d
+}
+
+// Capacity returns the maximum number of elements of a bounded deque, or 0 if the
+// deque is unbounded.
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) Capacity() int {
+	return d.capacity
+}
+
+// full reports whether a bounded deque has no free capacity. The caller must hold the lock.
+func (d *Deque[T]) full() bool {
+	return d.capacity > 0 && d.data.Size() >= d.capacity
+}
+
+// OnEvict sets a callback invoked with every element a bounded deque drops to make room
+// for an offered one, so that it can be logged or have its resources released. A nil
+// fn removes the callback.
+//
+// The callback runs after the deque's lock is released, so it may use the deque. It is
+// not called for elements removed by Poll, Take, Remove, Drain or Clear.
+//
+// Example:
+//
+//	d := deque.NewBoundedDeque[*Conn](64)
+//	d.OnEvict(func(c *Conn) { c.Close() })
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) OnEvict(fn func(T)) {
+	d.lock()
+	defer d.unlock()
+	d.onEvict = fn
+}
+
+// makeRoom drops an element from the front, or the back, of a full bounded deque. If an
+// eviction callback is set, the dropped element is appended to evicted, to be passed
+// to the callback once the lock is released. The caller must hold the lock.
+func (d *Deque[T]) makeRoom(fromFront bool, evicted []T) []T {
+	if !d.full() {
+		return evicted
+	}
+	var v T
+	if fromFront {
+		v, _ = d.data.RemoveFirst()
+	} else {
+		v, _ = d.data.RemoveLast()
+	}
+	if d.onEvict != nil {
+		evicted = append(evicted, v)
+	}
+	return evicted
+}
+
+// wait blocks until ready returns true or ctx is done, and returns ctx.Err() in the
+// latter case. The caller must hold the lock; it is released while waiting.
+func (d *Deque[T]) wait(ctx context.Context, cond *sync.Cond, ready func() bool) error {
+	if ready() {
+		return nil
 	}
+	// wake the waiters when ctx is done; taking the lock ensures the broadcast cannot
+	// slip in between the ctx check and cond.Wait below
+	stop := context.AfterFunc(ctx, func() {
+		d.lock()
+		defer d.unlock()
+		cond.Broadcast()
+	})
+	defer stop()
+	for !ready() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cond.Wait()
+	}
+	return nil
 }
 
 // OfferFirst inserts an element at the front of the deque.
 // Algorithm: Add element to the head of the underlying doubly linked list.
+// If a bounded deque is full, its last element is dropped first.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) OfferFirst(elem T) (bool, error) {
-	return d.data.AddFirst(elem)
+	d.lock()
+	evicted := d.makeRoom(false, nil)
+	onEvict := d.onEvict
+	d.notEmpty.Signal()
+	ok, err := d.data.AddFirst(elem)
+	d.unlock()
+
+	for _, v := range evicted {
+		onEvict(v)
+	}
+	return ok, err
 }
 
 // PollFirst removes and returns the first element of the deque.
-// Returns zero values and an error if the deque is empty.
+// Returns zero values and ErrEmpty if the deque is empty.
 // Algorithm: Remove element from the head of the linked list.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) PollFirst() (T, error) {
+	d.lock()
+	defer d.unlock()
+	if d.data.Size() == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	d.notFull.Signal()
 	return d.data.RemoveFirst()
 }
 
 // PeekFirst retrieves the first element without removing it.
-// Returns zero values and an error if the deque is empty.
+// Returns zero values and ErrEmpty if the deque is empty.
 // Algorithm: Access head element of the linked list.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) PeekFirst() (T, error) {
+	d.lock()
+	defer d.unlock()
+	if d.data.Size() == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
 	return d.data.PeekFirst()
 }
 
 // OfferLast inserts an element at the end of the deque.
 // Algorithm: Add element to the tail of the underlying doubly linked list.
+// If a bounded deque is full, its first element is dropped first.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) OfferLast(elem T) (bool, error) {
-	return d.data.AddLast(elem)
+	d.lock()
+	evicted := d.makeRoom(true, nil)
+	onEvict := d.onEvict
+	d.notEmpty.Signal()
+	ok, err := d.data.AddLast(elem)
+	d.unlock()
+
+	for _, v := range evicted {
+		onEvict(v)
+	}
+	return ok, err
 }
 
 // PollLast removes and returns the last element of the deque.
-// Returns zero values and an error if the deque is empty.
+// Returns zero values and ErrEmpty if the deque is empty.
 // Algorithm: Remove element from the tail of the linked list.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) PollLast() (T, error) {
+	d.lock()
+	defer d.unlock()
+	if d.data.Size() == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	d.notFull.Signal()
 	return d.data.RemoveLast()
 }
 
 // PeekLast retrieves the last element without removing it.
-// Returns zero values and an error if the deque is empty.
+// Returns zero values and ErrEmpty if the deque is empty.
 // Algorithm: Access tail element of the linked list.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) PeekLast() (T, error) {
+	d.lock()
+	defer d.unlock()
+	if d.data.Size() == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
 	return d.data.PeekLast()
 }
 
+// OfferAllFirst inserts elems at the front of the deque, keeping their order, so that
+// elems[0] becomes the first element. The lock is acquired once for the whole batch.
+// If a bounded deque overflows, elements are dropped from the end as for OfferFirst.
+//
+// Time Complexity: O(k), where k = len(elems)
+func (d *Deque[T]) OfferAllFirst(elems ...T) {
+	d.lock()
+	var evicted []T
+	for i := len(elems) - 1; i >= 0; i-- {
+		evicted = d.makeRoom(false, evicted)
+		_, _ = d.data.AddFirst(elems[i])
+	}
+	onEvict := d.onEvict
+	d.notEmpty.Broadcast()
+	d.unlock()
+
+	for _, v := range evicted {
+		onEvict(v)
+	}
+}
+
+// OfferAllLast inserts elems at the end of the deque, keeping their order. The lock is
+// acquired once for the whole batch. If a bounded deque overflows, elements are dropped
+// from the front as for OfferLast.
+//
+// Time Complexity: O(k), where k = len(elems)
+func (d *Deque[T]) OfferAllLast(elems ...T) {
+	d.lock()
+	var evicted []T
+	for _, elem := range elems {
+		evicted = d.makeRoom(true, evicted)
+		_, _ = d.data.AddLast(elem)
+	}
+	onEvict := d.onEvict
+	d.notEmpty.Broadcast()
+	d.unlock()
+
+	for _, v := range evicted {
+		onEvict(v)
+	}
+}
+
+// Drain removes and returns up to max elements from the front of the deque, in order,
+// under a single lock acquisition; a max <= 0 means no limit. It returns an empty
+// slice if the deque is empty.
+//
+// Time Complexity: O(k), where k = number of elements removed
+func (d *Deque[T]) Drain(max int) []T {
+	d.lock()
+	defer d.unlock()
+	n := d.data.Size()
+	if max > 0 && max < n {
+		n = max
+	}
+	out := make([]T, 0, n)
+	for range n {
+		v, _ := d.data.RemoveFirst()
+		out = append(out, v)
+	}
+	d.notFull.Broadcast()
+	return out
+}
+
+// PeekAt retrieves the element at index i (0 is the first element) without removing it.
+// Returns zero values and ErrInvalidIndex if i is out of range.
+// Algorithm: Walk the linked list from whichever end is closer to i.
+//
+// Time Complexity: O(min(i, n-i))
+func (d *Deque[T]) PeekAt(i int) (T, error) {
+	d.lock()
+	defer d.unlock()
+	if i < 0 || i >= d.data.Size() {
+		var zero T
+		return zero, ErrInvalidIndex
+	}
+	return d.data.Get(i)
+}
+
+// TakeFirst removes and returns the first element of the deque, waiting until one is
+// available or ctx is done. In the latter case it returns ctx.Err().
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	job, err := d.TakeFirst(ctx)
+//
+// Time Complexity: O(1), excluding the time spent waiting
+func (d *Deque[T]) TakeFirst(ctx context.Context) (T, error) {
+	d.lock()
+	defer d.unlock()
+	if err := d.wait(ctx, d.notEmpty, func() bool { return d.data.Size() > 0 }); err != nil {
+		var zero T
+		return zero, err
+	}
+	d.notFull.Signal()
+	return d.data.RemoveFirst()
+}
+
+// TakeLast removes and returns the last element of the deque, waiting until one is
+// available or ctx is done. In the latter case it returns ctx.Err().
+//
+// Time Complexity: O(1), excluding the time spent waiting
+func (d *Deque[T]) TakeLast(ctx context.Context) (T, error) {
+	d.lock()
+	defer d.unlock()
+	if err := d.wait(ctx, d.notEmpty, func() bool { return d.data.Size() > 0 }); err != nil {
+		var zero T
+		return zero, err
+	}
+	d.notFull.Signal()
+	return d.data.RemoveLast()
+}
+
+// PutFirst inserts an element at the front of the deque, waiting until a bounded deque
+// has free capacity or ctx is done. In the latter case it returns ctx.Err().
+// On an unbounded deque it never blocks.
+//
+// Time Complexity: O(1), excluding the time spent waiting
+func (d *Deque[T]) PutFirst(ctx context.Context, elem T) error {
+	d.lock()
+	defer d.unlock()
+	if err := d.wait(ctx, d.notFull, func() bool { return !d.full() }); err != nil {
+		return err
+	}
+	d.notEmpty.Signal()
+	_, err := d.data.AddFirst(elem)
+	return err
+}
+
+// PutLast inserts an element at the end of the deque, waiting until a bounded deque
+// has free capacity or ctx is done. In the latter case it returns ctx.Err().
+// On an unbounded deque it never blocks.
+//
+// Time Complexity: O(1), excluding the time spent waiting
+func (d *Deque[T]) PutLast(ctx context.Context, elem T) error {
+	d.lock()
+	defer d.unlock()
+	if err := d.wait(ctx, d.notFull, func() bool { return !d.full() }); err != nil {
+		return err
+	}
+	d.notEmpty.Signal()
+	_, err := d.data.AddLast(elem)
+	return err
+}
+
+// RemoveIf removes every element for which pred returns true, in a single traversal
+// under one lock acquisition, and returns the number of removed elements.
+//
+// The lock is held while pred runs, so pred must not use the deque.
+//
+// Example:
+//
+//	cancelled := d.RemoveIf(func(t Task) bool { return t.Tenant == "acme" })
+//
+// Time Complexity: O(n)
+func (d *Deque[T]) RemoveIf(pred func(T) bool) int {
+	d.lock()
+	defer d.unlock()
+	removed := d.data.RemoveIf(pred)
+	if removed > 0 {
+		d.notFull.Broadcast()
+	}
+	return removed
+}
+
+// Push inserts an element at the top of the deque, viewed as a stack.
+// It is an alias for OfferFirst, matching stack.Stack.Push.
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) Push(elem T) (bool, error) {
+	return d.OfferFirst(elem)
+}
+
+// Pop removes and returns the top element of the deque, viewed as a stack.
+// It is an alias for PollFirst, matching stack.Stack.Pop.
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) Pop() (T, error) {
+	return d.PollFirst()
+}
+
+// Top retrieves the top element of the deque, viewed as a stack, without removing it.
+// It is an alias for PeekFirst.
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) Top() (T, error) {
+	return d.PeekFirst()
+}
+
+// Enqueue inserts an element at the end of the deque, viewed as a queue.
+// It is an alias for OfferLast, matching queue.Queue.Enqueue.
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) Enqueue(elem T) {
+	_, _ = d.OfferLast(elem)
+}
+
+// Dequeue removes and returns the element at the front of the deque, viewed as a queue.
+// It is an alias for PollFirst, matching queue.Queue.Dequeue.
+//
+// Time Complexity: O(1)
+func (d *Deque[T]) Dequeue() (T, error) {
+	return d.PollFirst()
+}
+
 // Remove deletes the first occurrence of the specified element from the deque.
 // Returns true if an element was removed, false otherwise.
 // Algorithm: Traverse the linked list to find and remove the node.
 //
 // Time Complexity: O(n)
 func (d *Deque[T]) Remove(elem T) bool {
+	d.lock()
+	defer d.unlock()
 	ok, err := d.data.Remove(elem)
 	if err != nil {
 		return false
 	}
+	d.notFull.Signal()
 	return ok == elem
 }
 
+// ForEach calls fn for every element of the deque, from front to rear, until fn
+// returns false. No copy of the deque is made.
+//
+// The lock is held while fn runs, so fn must not use the deque.
+//
+// Example:
+//
+//	var found Task
+//	d.ForEach(func(t Task) bool {
+//	    if t.Urgent {
+//	        found = t
+//	        return false
+//	    }
+//	    return true
+//	})
+//
+// Time Complexity: O(n) worst case, O(k) when fn stops after k elements
+func (d *Deque[T]) ForEach(fn func(T) bool) {
+	d.lock()
+	defer d.unlock()
+	for v := range d.data.All() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Contains reports whether the deque holds the specified element.
+// Algorithm: Traverse the linked list until the element is found.
+//
+// Time Complexity: O(n)
+func (d *Deque[T]) Contains(elem T) bool {
+	d.lock()
+	defer d.unlock()
+	ok, _ := d.data.Contains(elem)
+	return ok
+}
+
+// Clear removes all elements from the deque, waking any goroutines blocked in
+// PutFirst or PutLast.
+//
+// Time Complexity: O(n)
+func (d *Deque[T]) Clear() {
+	d.lock()
+	defer d.unlock()
+	d.data.Clear()
+	d.notFull.Broadcast()
+}
+
+// Clone returns an independent copy of the deque with the same elements and capacity.
+// Later changes to either deque do not affect the other.
+//
+// Time Complexity: O(n)
+func (d *Deque[T]) Clone() *Deque[T] {
+	d.lock()
+	defer d.unlock()
+	c := NewDeque[T]()
+	c.capacity = d.capacity
+	c.data.AddAll(d.data.ToSlice()...)
+	return c
+}
+
+// Equal reports whether the deque and other hold the same elements in the same order.
+// Capacities are not compared.
+//
+// The deques are locked one after the other rather than together, so comparing two
+// deques that are modified concurrently compares two snapshots taken at different times.
+//
+// Time Complexity: O(n)
+func (d *Deque[T]) Equal(other *Deque[T]) bool {
+	if d == other {
+		return true
+	}
+	other.lock()
+	elems := other.data.ToSlice()
+	other.unlock()
+
+	d.lock()
+	defer d.unlock()
+	if d.data.Size() != len(elems) {
+		return false
+	}
+	i := 0
+	for v := range d.data.All() {
+		if v != elems[i] {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
 // Size returns the number of elements in the deque.
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) Size() int {
+	d.lock()
+	defer d.unlock()
 	return d.data.Size()
 }
 
@@ -113,5 +621,7 @@ func (d *Deque[T]) Size() int {
 //
 // Time Complexity: O(1)
 func (d *Deque[T]) IsEmpty() bool {
+	d.lock()
+	defer d.unlock()
 	return d.data.IsEmpty()
 }
@@ -256,3 +256,64 @@ func BenchmarkCoordinatedParallel(b *testing.B) {
 
 	wg.Wait()
 }
+
+// Benchmark OfferAllLast with batches of 64 against per-element OfferLast.
+func BenchmarkOfferAllLastBatch(b *testing.B) {
+	batch := make([]int, 64)
+	for i := range batch {
+		batch[i] = i
+	}
+	d := NewDeque[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.OfferAllLast(batch...)
+		d.Drain(0)
+	}
+}
+
+// Parallel offer/poll pairs on a single Deque against a StripedDeque.
+func BenchmarkStripedParallelMixed(b *testing.B) {
+	b.Run("Deque", func(b *testing.B) {
+		d := NewDeque[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				_, _ = d.OfferLast(i)
+				_, _ = d.PollFirst()
+			}
+		})
+	})
+	b.Run("StripedDeque", func(b *testing.B) {
+		d := NewStripedDeque[int](0)
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				_, _ = d.OfferLast(i)
+				_, _ = d.PollFirst()
+			}
+		})
+	})
+}
+
+// Benchmark ForEach stopping at the middle of a 1000-element deque.
+func BenchmarkForEachEarlyExit(b *testing.B) {
+	d := NewDeque[int]()
+	for i := 0; i < 1000; i++ {
+		_, _ = d.OfferLast(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.ForEach(func(v int) bool { return v < 500 })
+	}
+}
+
+// Benchmark OfferLast/PollFirst on a zero-value deque.
+func BenchmarkZeroValueOfferPoll(b *testing.B) {
+	var d Deque[int]
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = d.OfferLast(i)
+		_, _ = d.PollFirst()
+	}
+}
@@ -1,6 +1,9 @@
 package deque
 
 import (
+	"context"
+	"errors"
+	"reflect"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -19,17 +22,17 @@ func TestZeroValueDeque(t *testing.T) {
 		t.Fatalf("expected size 0, got %d", d.Size())
 	}
 
-	if _, err := d.PeekFirst(); err == nil {
-		t.Fatalf("expected error on PeekFirst for empty deque")
+	if _, err := d.PeekFirst(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PeekFirst for empty deque, got %v", err)
 	}
-	if _, err := d.PeekLast(); err == nil {
-		t.Fatalf("expected error on PeekLast for empty deque")
+	if _, err := d.PeekLast(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PeekLast for empty deque, got %v", err)
 	}
-	if _, err := d.PollFirst(); err == nil {
-		t.Fatalf("expected error on PollFirst for empty deque")
+	if _, err := d.PollFirst(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PollFirst for empty deque, got %v", err)
 	}
-	if _, err := d.PollLast(); err == nil {
-		t.Fatalf("expected error on PollLast for empty deque")
+	if _, err := d.PollLast(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PollLast for empty deque, got %v", err)
 	}
 }
 
@@ -197,17 +200,17 @@ func TestErrorsOnEmptyAfterDrains(t *testing.T) {
 	if !d.IsEmpty() {
 		t.Fatalf("expected empty after draining")
 	}
-	if _, err := d.PollFirst(); err == nil {
-		t.Fatalf("expected error on PollFirst after draining")
+	if _, err := d.PollFirst(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PollFirst after draining, got %v", err)
 	}
-	if _, err := d.PollLast(); err == nil {
-		t.Fatalf("expected error on PollLast after draining")
+	if _, err := d.PollLast(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PollLast after draining, got %v", err)
 	}
-	if _, err := d.PeekFirst(); err == nil {
-		t.Fatalf("expected error on PeekFirst after draining")
+	if _, err := d.PeekFirst(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PeekFirst after draining, got %v", err)
 	}
-	if _, err := d.PeekLast(); err == nil {
-		t.Fatalf("expected error on PeekLast after draining")
+	if _, err := d.PeekLast(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PeekLast after draining, got %v", err)
 	}
 }
 
@@ -308,3 +311,501 @@ func TestConcurrency(t *testing.T) {
 		t.Fatalf("expected deque to be empty at the end; size=%d", d.Size())
 	}
 }
+
+func TestBoundedDequeOfferDropsOppositeEnd(t *testing.T) {
+	d := NewBoundedDeque[int](3)
+	if d.Capacity() != 3 {
+		t.Fatalf("expected capacity 3, got %d", d.Capacity())
+	}
+	for i := 1; i <= 4; i++ {
+		if _, err := d.OfferLast(i); err != nil {
+			t.Fatalf("OfferLast(%d) error: %v", i, err)
+		}
+	}
+	// 1 was dropped from the front to make room for 4
+	if v, _ := d.PeekFirst(); v != 2 {
+		t.Fatalf("expected first 2, got %d", v)
+	}
+	_, _ = d.OfferFirst(0)
+	// 4 was dropped from the back to make room for 0
+	if v, _ := d.PeekLast(); v != 3 {
+		t.Fatalf("expected last 3, got %d", v)
+	}
+	if d.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", d.Size())
+	}
+	if NewBoundedDeque[int](0).Capacity() != 1 || NewDeque[int]().Capacity() != 0 {
+		t.Fatalf("unexpected capacity for clamped or unbounded deque")
+	}
+}
+
+func TestTakeBlocksUntilOffer(t *testing.T) {
+	d := NewDeque[int]()
+	got := make(chan int)
+	go func() {
+		v, err := d.TakeFirst(context.Background())
+		if err != nil {
+			t.Errorf("TakeFirst error: %v", err)
+		}
+		got <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	_, _ = d.OfferLast(42)
+	select {
+	case v := <-got:
+		if v != 42 {
+			t.Fatalf("expected 42, got %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("TakeFirst did not wake up after OfferLast")
+	}
+
+	_, _ = d.OfferLast(1)
+	_, _ = d.OfferLast(2)
+	if v, err := d.TakeLast(context.Background()); err != nil || v != 2 {
+		t.Fatalf("TakeLast = %d, %v; want 2, nil", v, err)
+	}
+}
+
+func TestTakeCancelled(t *testing.T) {
+	d := NewDeque[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := d.TakeLast(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := d.TakeFirst(cancelled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+}
+
+func TestPutBlocksWhileFull(t *testing.T) {
+	d := NewBoundedDeque[string](1)
+	if err := d.PutLast(context.Background(), "a"); err != nil {
+		t.Fatalf("PutLast error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := d.PutFirst(ctx, "b"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- d.PutFirst(context.Background(), "b")
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if v, _ := d.PollFirst(); v != "a" {
+		t.Fatalf("expected a, got %q", v)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("PutFirst error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("PutFirst did not wake up after PollFirst")
+	}
+	if v, _ := d.PeekFirst(); v != "b" || d.Size() != 1 {
+		t.Fatalf("expected [b], got first %q and size %d", v, d.Size())
+	}
+}
+
+func TestBlockingProducersConsumers(t *testing.T) {
+	const (
+		workers   = 4
+		perWorker = 500
+	)
+	d := NewBoundedDeque[int](8)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var sum atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= perWorker; i++ {
+				if err := d.PutLast(ctx, i); err != nil {
+					t.Errorf("PutLast error: %v", err)
+					return
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				v, err := d.TakeFirst(ctx)
+				if err != nil {
+					t.Errorf("TakeFirst error: %v", err)
+					return
+				}
+				sum.Add(int64(v))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if want := int64(workers * perWorker * (perWorker + 1) / 2); sum.Load() != want {
+		t.Fatalf("expected sum %d, got %d", want, sum.Load())
+	}
+	if !d.IsEmpty() {
+		t.Fatalf("expected empty deque, got size %d", d.Size())
+	}
+}
+
+func TestContainsAndClear(t *testing.T) {
+	d := NewDeque[string]()
+	if d.Contains("a") {
+		t.Fatalf("expected empty deque not to contain a")
+	}
+	_, _ = d.OfferLast("a")
+	_, _ = d.OfferFirst("b")
+	if !d.Contains("a") || !d.Contains("b") || d.Contains("c") {
+		t.Fatalf("unexpected Contains results")
+	}
+
+	d.Clear()
+	if !d.IsEmpty() || d.Contains("a") {
+		t.Fatalf("expected empty deque after Clear, got size %d", d.Size())
+	}
+	_, _ = d.OfferLast("c")
+	if v, _ := d.PeekFirst(); v != "c" {
+		t.Fatalf("expected deque to be usable after Clear, got %q", v)
+	}
+}
+
+func TestClearWakesBlockedPut(t *testing.T) {
+	d := NewBoundedDeque[int](1)
+	_, _ = d.OfferLast(1)
+	done := make(chan error)
+	go func() {
+		done <- d.PutLast(context.Background(), 2)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	d.Clear()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("PutLast error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("PutLast did not wake up after Clear")
+	}
+	if v, _ := d.PeekFirst(); v != 2 {
+		t.Fatalf("expected 2, got %d", v)
+	}
+}
+
+func TestPeekAt(t *testing.T) {
+	d := NewDeque[int]()
+	for i := 1; i <= 5; i++ {
+		_, _ = d.OfferLast(i)
+	}
+	_, _ = d.OfferFirst(0)
+	for i := 0; i <= 5; i++ {
+		if v, err := d.PeekAt(i); err != nil || v != i {
+			t.Fatalf("PeekAt(%d) = %d, %v; want %d", i, v, err, i)
+		}
+	}
+	if d.Size() != 6 {
+		t.Fatalf("expected PeekAt not to remove elements, size %d", d.Size())
+	}
+	for _, i := range []int{-1, 6} {
+		if _, err := d.PeekAt(i); err == nil {
+			t.Fatalf("expected error for PeekAt(%d)", i)
+		}
+	}
+}
+
+func TestStackAliases(t *testing.T) {
+	// the method set shared with stack.Stack
+	var s interface {
+		Push(int) (bool, error)
+		Pop() (int, error)
+		Size() int
+		IsEmpty() bool
+	} = NewDeque[int]()
+
+	for i := 1; i <= 3; i++ {
+		if _, err := s.Push(i); err != nil {
+			t.Fatalf("Push error: %v", err)
+		}
+	}
+	for want := 3; want >= 1; want-- {
+		if v, err := s.Pop(); err != nil || v != want {
+			t.Fatalf("Pop = %d, %v; want %d", v, err, want)
+		}
+	}
+	if _, err := NewDeque[int]().Top(); err == nil {
+		t.Fatalf("expected error on Top for empty deque")
+	}
+	d := NewDeque[int]()
+	_, _ = d.Push(7)
+	if v, _ := d.Top(); v != 7 || d.Size() != 1 {
+		t.Fatalf("Top = %d with size %d; want 7 with size 1", v, d.Size())
+	}
+}
+
+func TestQueueAliases(t *testing.T) {
+	// the method set shared with queue.Queue
+	var q interface {
+		Enqueue(string)
+		Dequeue() (string, error)
+		Size() int
+		IsEmpty() bool
+	} = NewDeque[string]()
+
+	for _, v := range []string{"a", "b", "c"} {
+		q.Enqueue(v)
+	}
+	for _, want := range []string{"a", "b", "c"} {
+		if v, err := q.Dequeue(); err != nil || v != want {
+			t.Fatalf("Dequeue = %q, %v; want %q", v, err, want)
+		}
+	}
+	if _, err := q.Dequeue(); err == nil || !q.IsEmpty() {
+		t.Fatalf("expected error on Dequeue for empty deque")
+	}
+}
+
+func TestOfferAllAndDrain(t *testing.T) {
+	d := NewDeque[int]()
+	d.OfferAllLast(3, 4, 5)
+	d.OfferAllFirst(1, 2)
+	d.OfferAllLast()
+
+	if got := d.Drain(2); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("Drain(2) = %v; want [1 2]", got)
+	}
+	if got := d.Drain(0); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		t.Fatalf("Drain(0) = %v; want [3 4 5]", got)
+	}
+	if got := d.Drain(10); len(got) != 0 || !d.IsEmpty() {
+		t.Fatalf("Drain on empty deque = %v", got)
+	}
+}
+
+func TestOfferAllBounded(t *testing.T) {
+	d := NewBoundedDeque[int](3)
+	d.OfferAllLast(1, 2, 3, 4)
+	if got := d.Drain(0); !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Fatalf("OfferAllLast overflow kept %v; want [2 3 4]", got)
+	}
+	d.OfferAllLast(5, 6)
+	d.OfferAllFirst(1, 2)
+	if got := d.Drain(0); !reflect.DeepEqual(got, []int{1, 2, 5}) {
+		t.Fatalf("OfferAllFirst overflow kept %v; want [1 2 5]", got)
+	}
+}
+
+func TestDrainWakesBlockedPuts(t *testing.T) {
+	d := NewBoundedDeque[int](2)
+	d.OfferAllLast(1, 2)
+	var wg sync.WaitGroup
+	for i := 3; i <= 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := d.PutLast(context.Background(), i); err != nil {
+				t.Errorf("PutLast error: %v", err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	if got := d.Drain(0); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("Drain(0) = %v; want [1 2]", got)
+	}
+	wg.Wait()
+	if d.Size() != 2 {
+		t.Fatalf("expected both puts to complete, size %d", d.Size())
+	}
+}
+
+func TestCloneAndEqual(t *testing.T) {
+	d := NewBoundedDeque[int](4)
+	d.OfferAllLast(1, 2, 3)
+	c := d.Clone()
+	if !d.Equal(c) || !c.Equal(d) || !d.Equal(d) {
+		t.Fatalf("expected clone to equal the original")
+	}
+	if c.Capacity() != 4 {
+		t.Fatalf("expected clone capacity 4, got %d", c.Capacity())
+	}
+
+	_, _ = c.OfferLast(4)
+	if d.Equal(c) || d.Size() != 3 {
+		t.Fatalf("expected clone to be independent of the original")
+	}
+	_, _ = c.PollLast()
+	_, _ = c.PollFirst()
+	_, _ = c.OfferFirst(9)
+	if d.Equal(c) {
+		t.Fatalf("expected deques with different elements not to be equal")
+	}
+
+	// capacity is not part of equality
+	u := NewDeque[int]()
+	u.OfferAllLast(1, 2, 3)
+	if !u.Equal(d) {
+		t.Fatalf("expected unbounded deque with the same elements to be equal")
+	}
+	if !NewDeque[int]().Equal(NewDeque[int]().Clone()) {
+		t.Fatalf("expected empty deques to be equal")
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	type task struct {
+		tenant string
+		id     int
+	}
+	d := NewDeque[task]()
+	d.OfferAllLast(task{"acme", 1}, task{"globex", 2}, task{"acme", 3}, task{"initech", 4}, task{"acme", 5})
+
+	if n := d.RemoveIf(func(t task) bool { return t.tenant == "acme" }); n != 3 {
+		t.Fatalf("RemoveIf removed %d; want 3", n)
+	}
+	if got := d.Drain(0); !reflect.DeepEqual(got, []task{{"globex", 2}, {"initech", 4}}) {
+		t.Fatalf("remaining = %v", got)
+	}
+	if n := d.RemoveIf(func(task) bool { return true }); n != 0 {
+		t.Fatalf("RemoveIf on empty deque removed %d", n)
+	}
+}
+
+func TestOnEvict(t *testing.T) {
+	d := NewBoundedDeque[int](2)
+	var evicted []int
+	d.OnEvict(func(v int) {
+		evicted = append(evicted, v)
+		// the callback runs without the lock held
+		_ = d.Size()
+	})
+
+	_, _ = d.OfferLast(1)
+	_, _ = d.OfferLast(2)
+	_, _ = d.OfferLast(3)  // drops 1
+	_, _ = d.OfferFirst(0) // drops 3
+	d.OfferAllLast(4, 5)   // drops 0, 2
+	d.OfferAllFirst(6)     // drops 5
+	if !reflect.DeepEqual(evicted, []int{1, 3, 0, 2, 5}) {
+		t.Fatalf("evicted = %v; want [1 3 0 2 5]", evicted)
+	}
+
+	// removals other than overflow do not trigger the callback
+	_, _ = d.PollFirst()
+	d.Clear()
+	if len(evicted) != 5 {
+		t.Fatalf("expected no further evictions, got %v", evicted)
+	}
+
+	d.OnEvict(nil)
+	d.OfferAllLast(7, 8, 9)
+	if got := d.Drain(0); !reflect.DeepEqual(got, []int{8, 9}) {
+		t.Fatalf("Drain(0) = %v; want [8 9]", got)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	d := NewDeque[int]()
+	d.OfferAllLast(1, 2, 3, 4, 5)
+
+	var seen []int
+	d.ForEach(func(v int) bool {
+		seen = append(seen, v)
+		return true
+	})
+	if !reflect.DeepEqual(seen, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("ForEach visited %v", seen)
+	}
+
+	seen = nil
+	d.ForEach(func(v int) bool {
+		seen = append(seen, v)
+		return v < 3
+	})
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Fatalf("ForEach with early exit visited %v; want [1 2 3]", seen)
+	}
+	if d.Size() != 5 {
+		t.Fatalf("expected ForEach not to modify the deque, size %d", d.Size())
+	}
+	NewDeque[int]().ForEach(func(int) bool {
+		t.Fatalf("expected no calls for empty deque")
+		return true
+	})
+}
+
+func TestZeroValueDequeWithoutConstructor(t *testing.T) {
+	var d Deque[int]
+	if !d.IsEmpty() || d.Size() != 0 || d.Capacity() != 0 {
+		t.Fatalf("expected zero-value deque to be empty and unbounded")
+	}
+	if _, err := d.PollFirst(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("expected ErrEmpty on PollFirst for empty deque, got %v", err)
+	}
+	_, _ = d.OfferLast(2)
+	_, _ = d.OfferFirst(1)
+	if v, _ := d.PeekAt(1); v != 2 {
+		t.Fatalf("expected 2 at index 1, got %d", v)
+	}
+
+	var other Deque[int]
+	if d.Equal(&other) || !other.Equal(&Deque[int]{}) {
+		t.Fatalf("unexpected Equal results for zero-value deques")
+	}
+	other.ForEach(func(int) bool {
+		t.Fatalf("expected no calls for zero-value deque")
+		return true
+	})
+
+	// blocking operations also work on the zero value
+	var blocking Deque[string]
+	got := make(chan string)
+	go func() {
+		v, _ := blocking.TakeFirst(context.Background())
+		got <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := blocking.PutLast(context.Background(), "x"); err != nil {
+		t.Fatalf("PutLast error: %v", err)
+	}
+	select {
+	case v := <-got:
+		if v != "x" {
+			t.Fatalf("expected x, got %q", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("TakeFirst did not wake up on zero-value deque")
+	}
+}
+
+func TestDequeSentinelErrors(t *testing.T) {
+	d := NewDeque[int]()
+	for name, op := range map[string]func() (int, error){
+		"Pop": d.Pop, "Top": d.Top, "Dequeue": d.Dequeue,
+	} {
+		if _, err := op(); !errors.Is(err, ErrEmpty) {
+			t.Errorf("%s: expected ErrEmpty, got %v", name, err)
+		}
+	}
+	d.OfferLast(1)
+	for _, i := range []int{-1, 1} {
+		if _, err := d.PeekAt(i); !errors.Is(err, ErrInvalidIndex) {
+			t.Errorf("PeekAt(%d): expected ErrInvalidIndex, got %v", i, err)
+		}
+	}
+	if _, err := NewStripedDeque[int](2).PollLast(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("StripedDeque.PollLast: expected ErrEmpty, got %v", err)
+	}
+	if _, err := NewMonotonicDeque[int](3).Max(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("MonotonicDeque.Max: expected ErrEmpty, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package deque
+
+import (
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// monoEntry is an element of a MonotonicDeque together with its push sequence number,
+// which tells when it slides out of the window.
+type monoEntry[T comparable] struct {
+	seq int
+	val T
+}
+
+// MonotonicDeque tracks the maximum and minimum of the last `window` pushed elements,
+// the classic sliding-window extremum structure.
+//
+// It keeps two Deques of candidates: maxes holds elements in decreasing order and mins
+// in increasing order, so the current extremum is always at the front. Push drops the
+// candidates that can no longer be an extremum from the back, and the ones that left
+// the window from the front. Each element enters and leaves each deque at most once,
+// so Push is amortized O(1) and Max / Min are O(1).
+//
+// Fields:
+//   - window: the number of most recent elements considered
+//   - less: the ordering of the elements
+//   - pushed: the number of elements pushed so far, used as the next sequence number
+//   - maxes: candidates for the maximum, decreasing from front to back
+//   - mins: candidates for the minimum, increasing from front to back
+//   - mutex: guards the monotonic deque, since Push updates both deques together
+//
+// Example:
+//
+//	m := deque.NewMonotonicDeque[int](3)
+//	for _, v := range []int{1, 3, -1, -3, 5} {
+//	    m.Push(v)
+//	}
+//	hi, _ := m.Max() // 5, the maximum of [-1 -3 5]
+//	lo, _ := m.Min() // -3
+type MonotonicDeque[T comparable] struct {
+	window int
+	less   func(a, b T) bool
+	pushed int
+	maxes  *Deque[monoEntry[T]]
+	mins   *Deque[monoEntry[T]]
+	mutex  sync.Mutex
+}
+
+// NewMonotonicDeque creates an empty MonotonicDeque over the last window elements of
+// an ordered type. A window below 1 is treated as 1.
+//
+// Time Complexity: O(1)
+func NewMonotonicDeque[T constraints.Ordered](window int) *MonotonicDeque[T] {
+	return NewMonotonicDequeWithComparator(window, func(a, b T) bool { return a < b })
+}
+
+// NewMonotonicDequeWithComparator creates an empty MonotonicDeque over the last window
+// elements, ordered by less. A window below 1 is treated as 1.
+//
+// Time Complexity: O(1)
+func NewMonotonicDequeWithComparator[T comparable](window int, less func(a, b T) bool) *MonotonicDeque[T] {
+	return &MonotonicDeque[T]{
+		window: max(window, 1),
+		less:   less,
+		maxes:  NewDeque[monoEntry[T]](),
+		mins:   NewDeque[monoEntry[T]](),
+	}
+}
+
+// Push adds elem as the newest element of the window; the oldest element slides out
+// once the window is full.
+//
+// Algorithm Steps:
+//   - Drop front candidates that are no longer in the window.
+//   - Drop back candidates of maxes smaller than elem, and of mins greater than elem.
+//   - Append elem to both deques.
+//
+// Time Complexity: O(1) amortized
+func (m *MonotonicDeque[T]) Push(elem T) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e := monoEntry[T]{seq: m.pushed, val: elem}
+	m.pushed++
+	oldest := m.pushed - m.window
+	push(m.maxes, e, oldest, func(back T) bool { return m.less(back, elem) })
+	push(m.mins, e, oldest, func(back T) bool { return m.less(elem, back) })
+}
+
+// push appends e to a candidate deque after dropping the entries older than oldest from
+// the front and the entries dominated by e from the back.
+func push[T comparable](d *Deque[monoEntry[T]], e monoEntry[T], oldest int, dominated func(T) bool) {
+	for {
+		front, err := d.PeekFirst()
+		if err != nil || front.seq >= oldest {
+			break
+		}
+		_, _ = d.PollFirst()
+	}
+	for {
+		back, err := d.PeekLast()
+		if err != nil || !dominated(back.val) {
+			break
+		}
+		_, _ = d.PollLast()
+	}
+	_, _ = d.OfferLast(e)
+}
+
+// Max returns the maximum of the elements in the window.
+// Returns ErrEmpty if nothing has been pushed yet.
+//
+// Time Complexity: O(1)
+func (m *MonotonicDeque[T]) Max() (T, error) {
+	return m.front(m.maxes)
+}
+
+// Min returns the minimum of the elements in the window.
+// Returns ErrEmpty if nothing has been pushed yet.
+//
+// Time Complexity: O(1)
+func (m *MonotonicDeque[T]) Min() (T, error) {
+	return m.front(m.mins)
+}
+
+// front returns the value at the front of a candidate deque.
+func (m *MonotonicDeque[T]) front(d *Deque[monoEntry[T]]) (T, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e, err := d.PeekFirst()
+	if err != nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return e.val, nil
+}
+
+// Len returns the number of elements currently in the window.
+//
+// Time Complexity: O(1)
+func (m *MonotonicDeque[T]) Len() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return min(m.pushed, m.window)
+}
+
+// Window returns the size of the sliding window.
+//
+// Time Complexity: O(1)
+func (m *MonotonicDeque[T]) Window() int {
+	return m.window
+}
@@ -0,0 +1,72 @@
+package deque
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestMonotonicDequeSlidingWindow(t *testing.T) {
+	m := NewMonotonicDeque[int](3)
+	if _, err := m.Max(); err == nil {
+		t.Fatalf("expected error on Max for empty window")
+	}
+	if _, err := m.Min(); err == nil {
+		t.Fatalf("expected error on Min for empty window")
+	}
+
+	var maxes, mins []int
+	for _, v := range []int{1, 3, -1, -3, 5, 3, 6, 7} {
+		m.Push(v)
+		hi, _ := m.Max()
+		lo, _ := m.Min()
+		maxes = append(maxes, hi)
+		mins = append(mins, lo)
+	}
+	if want := []int{1, 3, 3, 3, 5, 5, 6, 7}; !reflect.DeepEqual(maxes, want) {
+		t.Fatalf("maxes = %v; want %v", maxes, want)
+	}
+	if want := []int{1, 1, -1, -3, -3, -3, 3, 3}; !reflect.DeepEqual(mins, want) {
+		t.Fatalf("mins = %v; want %v", mins, want)
+	}
+	if m.Len() != 3 || m.Window() != 3 {
+		t.Fatalf("Len() = %d, Window() = %d; want 3, 3", m.Len(), m.Window())
+	}
+}
+
+func TestMonotonicDequeMatchesBruteForce(t *testing.T) {
+	values := []int{5, 5, 2, 8, 8, 1, 9, 0, 0, 7, 3, 3, 6, 4, 2, 9}
+	for _, window := range []int{1, 2, 4, 16} {
+		m := NewMonotonicDeque[int](window)
+		for i, v := range values {
+			m.Push(v)
+			w := values[max(0, i+1-window) : i+1]
+			if hi, _ := m.Max(); hi != slices.Max(w) {
+				t.Fatalf("window %d, step %d: Max() = %d; want %d", window, i, hi, slices.Max(w))
+			}
+			if lo, _ := m.Min(); lo != slices.Min(w) {
+				t.Fatalf("window %d, step %d: Min() = %d; want %d", window, i, lo, slices.Min(w))
+			}
+		}
+	}
+}
+
+func TestMonotonicDequeWithComparator(t *testing.T) {
+	type reading struct {
+		sensor string
+		value  float64
+	}
+	m := NewMonotonicDequeWithComparator(2, func(a, b reading) bool { return a.value < b.value })
+	m.Push(reading{"a", 1.5})
+	m.Push(reading{"b", 0.5})
+	m.Push(reading{"c", 0.7})
+	if hi, _ := m.Max(); hi.sensor != "c" {
+		t.Fatalf("Max() = %v; want sensor c", hi)
+	}
+	if lo, _ := m.Min(); lo.sensor != "b" {
+		t.Fatalf("Min() = %v; want sensor b", lo)
+	}
+	if NewMonotonicDeque[int](0).Window() != 1 {
+		t.Fatalf("expected window below 1 to be treated as 1")
+	}
+}
@@ -0,0 +1,143 @@
+package deque
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// StripedDeque is a concurrent deque for multi-producer multi-consumer workloads that
+// spreads its elements across independent Deques (stripes), so that producers and
+// consumers on different stripes do not contend on a single mutex.
+//
+// Offers go to the stripes in round-robin order. Polls start at a round-robin stripe
+// and move on to the next one while the stripe is empty, so an element is found as
+// long as any stripe holds one.
+//
+// Note: ordering is only guaranteed per stripe. OfferLast followed by PollFirst is FIFO
+// within a stripe, but elements on different stripes may be returned in any order.
+// Use a Deque when a strict global order is required.
+//
+// Fields:
+//   - stripes: independent Deques, each protected by its own mutex
+//   - offers: round-robin counter choosing the stripe of the next offer
+//   - polls: round-robin counter choosing the first stripe of the next poll
+//
+// Example:
+//
+//	sd := deque.NewStripedDeque[Job](0)
+//	for range workers {
+//	    go func() {
+//	        for {
+//	            job, err := sd.PollFirst()
+//	            ...
+//	        }
+//	    }()
+//	}
+//	sd.OfferLast(job)
+type StripedDeque[T comparable] struct {
+	stripes []*Deque[T]
+	offers  atomic.Uint64
+	polls   atomic.Uint64
+}
+
+// NewStripedDeque creates an empty StripedDeque with the given number of stripes.
+//
+// If stripes <= 0, the number of stripes defaults to runtime.GOMAXPROCS(0).
+//
+// Time Complexity: O(s), where s = number of stripes
+func NewStripedDeque[T comparable](stripes int) *StripedDeque[T] {
+	if stripes <= 0 {
+		stripes = runtime.GOMAXPROCS(0)
+	}
+	sd := &StripedDeque[T]{stripes: make([]*Deque[T], stripes)}
+	for i := range sd.stripes {
+		sd.stripes[i] = NewDeque[T]()
+	}
+	return sd
+}
+
+// next returns the stripe index selected by a round-robin counter.
+//
+// Time Complexity: O(1)
+func (sd *StripedDeque[T]) next(counter *atomic.Uint64) int {
+	return int((counter.Add(1) - 1) % uint64(len(sd.stripes)))
+}
+
+// OfferFirst inserts an element at the front of the next stripe.
+//
+// Time Complexity: O(1)
+func (sd *StripedDeque[T]) OfferFirst(elem T) (bool, error) {
+	return sd.stripes[sd.next(&sd.offers)].OfferFirst(elem)
+}
+
+// OfferLast inserts an element at the end of the next stripe.
+//
+// Time Complexity: O(1)
+func (sd *StripedDeque[T]) OfferLast(elem T) (bool, error) {
+	return sd.stripes[sd.next(&sd.offers)].OfferLast(elem)
+}
+
+// PollFirst removes and returns the first element of the first non-empty stripe,
+// starting from the next stripe in round-robin order.
+// Returns zero values and ErrEmpty if every stripe is empty.
+//
+// Time Complexity: O(s) worst case, O(1) when the stripes are evenly filled
+func (sd *StripedDeque[T]) PollFirst() (T, error) {
+	return sd.poll((*Deque[T]).PollFirst)
+}
+
+// PollLast removes and returns the last element of the first non-empty stripe,
+// starting from the next stripe in round-robin order.
+// Returns zero values and ErrEmpty if every stripe is empty.
+//
+// Time Complexity: O(s) worst case, O(1) when the stripes are evenly filled
+func (sd *StripedDeque[T]) PollLast() (T, error) {
+	return sd.poll((*Deque[T]).PollLast)
+}
+
+// poll applies take to the stripes, starting from the next one in round-robin order,
+// until it succeeds.
+func (sd *StripedDeque[T]) poll(take func(*Deque[T]) (T, error)) (T, error) {
+	start := sd.next(&sd.polls)
+	var (
+		v   T
+		err error
+	)
+	for i := range sd.stripes {
+		if v, err = take(sd.stripes[(start+i)%len(sd.stripes)]); err == nil {
+			return v, nil
+		}
+	}
+	return v, err
+}
+
+// Size returns the total number of elements in all stripes. Under concurrent
+// modification the result is approximate, since the stripes are counted one by one.
+//
+// Time Complexity: O(s)
+func (sd *StripedDeque[T]) Size() int {
+	n := 0
+	for _, s := range sd.stripes {
+		n += s.Size()
+	}
+	return n
+}
+
+// IsEmpty reports whether every stripe is empty.
+//
+// Time Complexity: O(s)
+func (sd *StripedDeque[T]) IsEmpty() bool {
+	for _, s := range sd.stripes {
+		if !s.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Stripes returns the number of stripes.
+//
+// Time Complexity: O(1)
+func (sd *StripedDeque[T]) Stripes() int {
+	return len(sd.stripes)
+}
@@ -0,0 +1,106 @@
+package deque
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestStripedDequeBasic(t *testing.T) {
+	sd := NewStripedDeque[int](4)
+	if sd.Stripes() != 4 {
+		t.Fatalf("expected 4 stripes, got %d", sd.Stripes())
+	}
+	if _, err := sd.PollFirst(); err == nil {
+		t.Fatalf("expected error on PollFirst for empty deque")
+	}
+	if _, err := sd.PollLast(); err == nil {
+		t.Fatalf("expected error on PollLast for empty deque")
+	}
+
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			_, _ = sd.OfferFirst(i)
+		} else {
+			_, _ = sd.OfferLast(i)
+		}
+	}
+	if sd.Size() != 10 || sd.IsEmpty() {
+		t.Fatalf("expected size 10, got %d", sd.Size())
+	}
+
+	var got []int
+	for !sd.IsEmpty() {
+		v, err := sd.PollLast()
+		if err != nil {
+			t.Fatalf("PollLast error: %v", err)
+		}
+		got = append(got, v)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatalf("polled %v", got)
+	}
+	if NewStripedDeque[int](0).Stripes() != runtime.GOMAXPROCS(0) {
+		t.Fatalf("expected default stripes to be GOMAXPROCS")
+	}
+}
+
+func TestStripedDequeSingleStripeIsFIFO(t *testing.T) {
+	sd := NewStripedDeque[int](1)
+	for i := 0; i < 5; i++ {
+		_, _ = sd.OfferLast(i)
+	}
+	for want := 0; want < 5; want++ {
+		if v, _ := sd.PollFirst(); v != want {
+			t.Fatalf("PollFirst = %d; want %d", v, want)
+		}
+	}
+}
+
+func TestStripedDequeConcurrent(t *testing.T) {
+	const (
+		producers   = 8
+		perProducer = 1000
+	)
+	sd := NewStripedDeque[int](4)
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				_, _ = sd.OfferLast(p*perProducer + i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make([]bool, producers*perProducer)
+	var mu sync.Mutex
+	for c := 0; c < producers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, err := sd.PollFirst()
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				if seen[v] {
+					t.Errorf("element %d polled twice", v)
+				}
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("element %d was lost", v)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package dsu
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestDisjointSetUnionFind(t *testing.T) {
+	d := New[string]()
+	if !d.Add("a") || d.Add("a") {
+		t.Errorf("Add: expected true once")
+	}
+	if !d.Connected("a", "a") || d.Connected("a", "z") || d.Connected("z", "z") {
+		t.Errorf("Connected: unexpected result for singletons or missing elements")
+	}
+	if _, ok := d.Find("z"); ok {
+		t.Errorf("Find: expected false for a missing element")
+	}
+
+	if !d.Union("a", "b") || !d.Union("c", "d") || !d.Union("b", "e") {
+		t.Errorf("Union: expected to merge distinct components")
+	}
+	if d.Union("e", "a") {
+		t.Errorf("Union: expected false for connected elements")
+	}
+	if d.Len() != 5 || d.Count() != 2 {
+		t.Errorf("Expected 5 elements in 2 components, got %d and %d", d.Len(), d.Count())
+	}
+	if !d.Connected("a", "e") || d.Connected("a", "c") {
+		t.Errorf("Connected: unexpected result")
+	}
+	ra, _ := d.Find("a")
+	re, _ := d.Find("e")
+	if ra != re {
+		t.Errorf("Find: expected a and e to share a representative, got %q and %q", ra, re)
+	}
+	if d.ComponentSize("b") != 3 || d.ComponentSize("d") != 2 || d.ComponentSize("z") != 0 {
+		t.Errorf("ComponentSize: unexpected sizes")
+	}
+
+	want := [][]string{{"a", "b", "e"}, {"c", "d"}}
+	if got := d.Components(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Components = %v; want %v", got, want)
+	}
+	var first [][]string
+	for c := range d.All() {
+		first = append(first, c)
+		break
+	}
+	if !reflect.DeepEqual(first, want[:1]) {
+		t.Errorf("All with early exit = %v; want %v", first, want[:1])
+	}
+
+	d.Union("d", "a")
+	if d.Count() != 1 || d.ComponentSize("c") != 5 {
+		t.Errorf("Expected a single component of 5, got %d components", d.Count())
+	}
+}
+
+func TestDisjointSetFromSlice(t *testing.T) {
+	d := NewFromSlice([]int{3, 1, 3, 2})
+	if d.Len() != 3 || d.Count() != 3 || !d.Contains(2) || d.Contains(4) {
+		t.Errorf("Expected 3 singletons, got %v", d.Components())
+	}
+}
+
+func TestDisjointSetLongChain(t *testing.T) {
+	const n = 100000
+	d := New[int]()
+	for i := 1; i < n; i++ {
+		d.Union(i-1, i)
+	}
+	if d.Count() != 1 || !d.Connected(0, n-1) || d.ComponentSize(n/2) != n {
+		t.Errorf("Expected one component of %d elements", n)
+	}
+	for i := range d.rank {
+		if d.rank[i] > 20 {
+			t.Fatalf("rank %d at %d; union by rank should keep trees shallow", d.rank[i], i)
+		}
+	}
+}
+
+func TestDisjointSetConcurrent(t *testing.T) {
+	d := New[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				d.Union(i%10, i)
+				d.Connected(g, i)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if d.Len() != 1000 || d.Count() != 10 {
+		t.Errorf("Expected 1000 elements in 10 components, got %d and %d", d.Len(), d.Count())
+	}
+}
@@ -0,0 +1,58 @@
+package graph
+
+import "testing"
+
+// grid builds an undirected n x n grid graph with unit weights.
+func grid(n int) *Graph[int, int] {
+	g := NewUndirected[int, int]()
+	for i := 0; i < n*n; i++ {
+		if i%n+1 < n {
+			g.AddEdge(i, i+1, 1)
+		}
+		if i+n < n*n {
+			g.AddEdge(i, i+n, 1)
+		}
+	}
+	return g
+}
+
+// Benchmark Dijkstra across a 100x100 grid.
+func BenchmarkShortestPathGrid(b *testing.B) {
+	g := grid(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := ShortestPath(g, 0, 100*100-1); err != nil {
+			b.Fatalf("ShortestPath error: %v", err)
+		}
+	}
+}
+
+// Benchmark A* with a Manhattan heuristic across the same grid.
+func BenchmarkAStarGrid(b *testing.B) {
+	const n = 100
+	g := grid(n)
+	target := n*n - 1
+	h := func(v int) int {
+		dx, dy := target%n-v%n, target/n-v/n
+		return dx + dy
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := AStar(g, 0, target, h); err != nil {
+			b.Fatalf("AStar error: %v", err)
+		}
+	}
+}
+
+// Benchmark a full BFS over the grid.
+func BenchmarkBFSGrid(b *testing.B) {
+	g := grid(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range g.BFS(0) {
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// collect returns the nodes yielded by seq.
+func collect[N any](seq func(func(N) bool)) []N {
+	var out []N
+	for n := range seq {
+		out = append(out, n)
+	}
+	return out
+}
+
+func TestGraphBuild(t *testing.T) {
+	g := NewDirected[string, int]()
+	if !g.AddNode("a") || g.AddNode("a") {
+		t.Errorf("AddNode: expected true once")
+	}
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 2)
+	if !g.HasEdge("a", "b") || g.HasEdge("b", "a") || !g.HasNode("c") || g.HasNode("z") {
+		t.Errorf("Unexpected directed edges or nodes")
+	}
+	if g.NodeCount() != 3 || g.EdgeCount() != 2 || !g.Directed() {
+		t.Errorf("Expected 3 nodes and 2 edges, got %d and %d", g.NodeCount(), g.EdgeCount())
+	}
+	if got := g.Nodes(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Nodes = %v; want insertion order", got)
+	}
+	edges, err := g.Neighbors("b")
+	if err != nil || !reflect.DeepEqual(edges, []Edge[string, int]{{From: "b", To: "c", Weight: 2}}) {
+		t.Errorf("Neighbors(b) = %v, %v", edges, err)
+	}
+	if _, err := g.Neighbors("z"); !errors.Is(err, ErrNodeNotFound) {
+		t.Errorf("Neighbors(z): expected ErrNodeNotFound, got %v", err)
+	}
+
+	u := NewUndirected[int, struct{}]()
+	u.AddEdge(1, 2, struct{}{})
+	if !u.HasEdge(2, 1) || u.EdgeCount() != 1 || u.Directed() {
+		t.Errorf("Expected undirected edge both ways, counted once")
+	}
+}
+
+func TestGraphTraversals(t *testing.T) {
+	//   1 → 2 → 4
+	//   ↓   ↓
+	//   3 → 5    6 (unreachable)
+	g := NewDirected[int, struct{}]()
+	for _, e := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}, {3, 5}} {
+		g.AddEdge(e[0], e[1], struct{}{})
+	}
+	g.AddNode(6)
+
+	if got := collect(g.BFS(1)); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("BFS = %v; want [1 2 3 4 5]", got)
+	}
+	if got := collect(g.DFS(1)); !reflect.DeepEqual(got, []int{1, 2, 4, 5, 3}) {
+		t.Errorf("DFS = %v; want [1 2 4 5 3]", got)
+	}
+	if got := collect(g.BFS(42)); got != nil {
+		t.Errorf("BFS from a missing node = %v; want nothing", got)
+	}
+
+	var first []int
+	for n := range g.DFS(1) {
+		first = append(first, n)
+		if len(first) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(first, []int{1, 2}) {
+		t.Errorf("DFS with early exit = %v; want [1 2]", first)
+	}
+
+	// the loop body may modify the graph without deadlocking
+	for n := range g.BFS(1) {
+		if n == 1 {
+			g.AddEdge(5, 7, struct{}{})
+		}
+	}
+}
+
+func TestGraphTopologicalSort(t *testing.T) {
+	g := NewDirected[string, struct{}]()
+	for _, e := range [][2]string{{"shirt", "tie"}, {"tie", "jacket"}, {"pants", "shoes"}, {"pants", "belt"}, {"belt", "jacket"}, {"shirt", "belt"}} {
+		g.AddEdge(e[0], e[1], struct{}{})
+	}
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatalf("TopologicalSort error: %v", err)
+	}
+	if want := []string{"shirt", "pants", "tie", "shoes", "belt", "jacket"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("TopologicalSort = %v; want %v", order, want)
+	}
+	if g.HasCycle() {
+		t.Errorf("HasCycle: expected false for a DAG")
+	}
+
+	g.AddEdge("jacket", "shirt", struct{}{})
+	if _, err := g.TopologicalSort(); !errors.Is(err, ErrCycle) {
+		t.Errorf("Expected ErrCycle, got %v", err)
+	}
+	if !g.HasCycle() {
+		t.Errorf("HasCycle: expected true")
+	}
+
+	if _, err := NewUndirected[int, int]().TopologicalSort(); !errors.Is(err, ErrUndirected) {
+		t.Errorf("Expected ErrUndirected, got %v", err)
+	}
+}
+
+func TestGraphUndirectedCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][2]int
+		want  bool
+	}{
+		{"single edge", [][2]int{{1, 2}}, false},
+		{"tree", [][2]int{{1, 2}, {1, 3}, {3, 4}, {3, 5}}, false},
+		{"forest", [][2]int{{1, 2}, {3, 4}}, false},
+		{"triangle", [][2]int{{1, 2}, {2, 3}, {3, 1}}, true},
+		{"cycle in second component", [][2]int{{1, 2}, {3, 4}, {4, 5}, {5, 3}}, true},
+		{"parallel edges", [][2]int{{1, 2}, {2, 1}}, true},
+		{"self-loop", [][2]int{{1, 1}}, true},
+	}
+	for _, tt := range tests {
+		g := NewUndirected[int, struct{}]()
+		for _, e := range tt.edges {
+			g.AddEdge(e[0], e[1], struct{}{})
+		}
+		if got := g.HasCycle(); got != tt.want {
+			t.Errorf("%s: HasCycle = %v; want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGraphConcurrent(t *testing.T) {
+	g := NewUndirected[int, int]()
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				g.AddEdge(w*100+i, w*100+i+1, 1)
+				collect(g.BFS(w * 100))
+			}
+		}(w)
+	}
+	wg.Wait()
+	if g.EdgeCount() != 400 {
+		t.Errorf("Expected 400 edges, got %d", g.EdgeCount())
+	}
+	if nodes := g.Nodes(); !slices.Contains(nodes, 400) {
+		t.Errorf("Expected node 400")
+	}
+}
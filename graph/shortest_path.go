@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"slices"
+
+	"github.com/Zubayear/ryushin/priorityqueue"
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the constraint for edge weights usable in shortest-path searches.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// queued is a node on the search frontier, with its tentative distance from the
+// source and its priority (distance plus heuristic estimate).
+type queued[N comparable, W Number] struct {
+	node       N
+	dist, prio W
+}
+
+// search runs A* from source with heuristic h, or Dijkstra when h is nil, and returns
+// the distances and predecessors of the settled nodes. It stops as soon as target is
+// settled if target is not nil.
+//
+// Algorithm Steps:
+//  1. Push the source with distance 0.
+//  2. Poll the frontier node with the smallest priority; skip it if it was already
+//     settled with a shorter distance (lazy deletion instead of decrease-key).
+//  3. Relax every outgoing edge, pushing neighbors whose distance improved.
+//  4. Repeat until the frontier is empty or the target is settled.
+func search[N comparable, W Number](g *Graph[N, W], source N, target *N, h func(N) W) (map[N]W, map[N]N, error) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	if _, ok := g.adj[source]; !ok {
+		return nil, nil, ErrNodeNotFound
+	}
+	if target != nil {
+		if _, ok := g.adj[*target]; !ok {
+			return nil, nil, ErrNodeNotFound
+		}
+	}
+	if h == nil {
+		h = func(N) W { return 0 }
+	}
+
+	dist := map[N]W{source: 0}
+	prev := make(map[N]N)
+	settled := make(map[N]bool)
+	frontier := priorityqueue.NewBinaryHeapWithComparator(func(a, b queued[N, W]) bool {
+		return a.prio < b.prio
+	})
+	frontier.Add(queued[N, W]{node: source, prio: h(source)})
+	for !frontier.IsEmpty() {
+		q, _ := frontier.Poll()
+		if settled[q.node] || q.dist > dist[q.node] {
+			continue
+		}
+		settled[q.node] = true
+		if target != nil && q.node == *target {
+			break
+		}
+		for _, e := range g.adj[q.node] {
+			if e.Weight < 0 {
+				return nil, nil, ErrNegativeWeight
+			}
+			nd := q.dist + e.Weight
+			if d, ok := dist[e.To]; !ok || nd < d {
+				dist[e.To] = nd
+				prev[e.To] = q.node
+				frontier.Add(queued[N, W]{node: e.To, dist: nd, prio: nd + h(e.To)})
+			}
+		}
+	}
+	return dist, prev, nil
+}
+
+// path rebuilds the path from source to target from the predecessor map.
+func path[N comparable](prev map[N]N, source, target N) []N {
+	p := []N{target}
+	for n := target; n != source; {
+		n = prev[n]
+		p = append(p, n)
+	}
+	slices.Reverse(p)
+	return p
+}
+
+// ShortestPaths returns the length of the shortest path from source to every node
+// reachable from it, including source itself at distance 0.
+// Returns ErrNodeNotFound if source is not in g, and ErrNegativeWeight if a reachable
+// edge has a negative weight, which Dijkstra's algorithm cannot handle.
+//
+// Algorithm: Dijkstra's algorithm with a binary heap.
+//
+// Time Complexity: O((V + E) log V)
+func ShortestPaths[N comparable, W Number](g *Graph[N, W], source N) (map[N]W, error) {
+	dist, _, err := search(g, source, nil, nil)
+	return dist, err
+}
+
+// ShortestPath returns a shortest path from source to target, both included, and its
+// length. Returns ErrNodeNotFound if either node is not in g, ErrNoPath if target is
+// unreachable, and ErrNegativeWeight if the search meets a negative weight.
+//
+// Example:
+//
+//	path, dist, err := graph.ShortestPath(g, "a", "b")
+//
+// Algorithm: Dijkstra's algorithm with a binary heap, stopping once target is settled.
+//
+// Time Complexity: O((V + E) log V)
+func ShortestPath[N comparable, W Number](g *Graph[N, W], source, target N) ([]N, W, error) {
+	return AStar(g, source, target, nil)
+}
+
+// AStar returns a shortest path from source to target and its length, like
+// ShortestPath, but guides the search with the heuristic h, which estimates the
+// remaining distance from a node to target. A good heuristic settles far fewer nodes
+// than Dijkstra, e.g. the straight-line distance on a map.
+//
+// h must never overestimate the remaining distance (be admissible) for the result to
+// be a shortest path, and should be consistent: h(u) <= w(u, v) + h(v) for every edge.
+// A nil h searches like Dijkstra. The graph is read-locked during the search, so h
+// must not use g.
+//
+// Time Complexity: O((V + E) log V) worst case
+func AStar[N comparable, W Number](g *Graph[N, W], source, target N, h func(N) W) ([]N, W, error) {
+	dist, prev, err := search(g, source, &target, h)
+	if err != nil {
+		return nil, 0, err
+	}
+	d, ok := dist[target]
+	if !ok {
+		return nil, 0, ErrNoPath
+	}
+	return path(prev, source, target), d, nil
+}
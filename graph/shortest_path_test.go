@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestShortestPath(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("c", "b", 2)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("c", "d", 5)
+	g.AddNode("e")
+
+	p, d, err := ShortestPath(g, "a", "d")
+	if err != nil || d != 4 || !reflect.DeepEqual(p, []string{"a", "c", "b", "d"}) {
+		t.Errorf("ShortestPath(a, d) = %v, %v, %v; want [a c b d], 4", p, d, err)
+	}
+	if p, d, err := ShortestPath(g, "a", "a"); err != nil || d != 0 || !reflect.DeepEqual(p, []string{"a"}) {
+		t.Errorf("ShortestPath(a, a) = %v, %v, %v; want [a], 0", p, d, err)
+	}
+	if _, _, err := ShortestPath(g, "a", "e"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Expected ErrNoPath, got %v", err)
+	}
+	if _, _, err := ShortestPath(g, "a", "z"); !errors.Is(err, ErrNodeNotFound) {
+		t.Errorf("Expected ErrNodeNotFound, got %v", err)
+	}
+
+	dist, err := ShortestPaths(g, "a")
+	if want := map[string]int{"a": 0, "b": 3, "c": 1, "d": 4}; err != nil || !reflect.DeepEqual(dist, want) {
+		t.Errorf("ShortestPaths(a) = %v, %v; want %v", dist, err, want)
+	}
+
+	g.AddEdge("d", "e", -1)
+	if _, err := ShortestPaths(g, "a"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestAStarGrid(t *testing.T) {
+	type cell struct{ x, y int }
+	// 10x10 grid with a wall at x = 5 except at y = 9
+	g := NewUndirected[cell, float64]()
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 10; y++ {
+			if x == 5 && y != 9 {
+				continue
+			}
+			if x+1 < 10 && !(x+1 == 5 && y != 9) {
+				g.AddEdge(cell{x, y}, cell{x + 1, y}, 1)
+			}
+			if y+1 < 10 && x != 5 {
+				g.AddEdge(cell{x, y}, cell{x, y + 1}, 1)
+			}
+		}
+	}
+	target := cell{9, 0}
+	h := func(c cell) float64 { return math.Abs(float64(c.x-target.x)) + math.Abs(float64(c.y-target.y)) }
+
+	p, d, err := AStar(g, cell{0, 0}, target, h)
+	if err != nil || d != 27 || len(p) != 28 {
+		t.Fatalf("AStar = %d cells, %v, %v; want 28 cells, 27", len(p), d, err)
+	}
+	if _, dd, _ := ShortestPath(g, cell{0, 0}, target); dd != d {
+		t.Errorf("Expected A* and Dijkstra to agree, got %v and %v", d, dd)
+	}
+	for i := 1; i < len(p); i++ {
+		if !g.HasEdge(p[i-1], p[i]) {
+			t.Fatalf("Path step %v -> %v is not an edge", p[i-1], p[i])
+		}
+	}
+}
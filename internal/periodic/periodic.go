@@ -0,0 +1,34 @@
+// Package periodic runs background maintenance work, such as purging expired entries,
+// on a fixed interval.
+package periodic
+
+import (
+	"sync"
+	"time"
+)
+
+// Start calls fn every interval on a new goroutine until the returned stop function
+// is called; calling stop more than once is safe. It panics if interval is not
+// positive.
+func Start(interval time.Duration, fn func()) (stop func()) {
+	if interval <= 0 {
+		panic("periodic: non-positive interval")
+	}
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
@@ -0,0 +1,30 @@
+package periodic
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStart(t *testing.T) {
+	var calls atomic.Int32
+	stop := Start(time.Millisecond, func() { calls.Add(1) })
+	deadline := time.Now().Add(time.Second)
+	for calls.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+	stop()
+	if calls.Load() < 2 {
+		t.Fatalf("Expected fn to run repeatedly, got %d calls", calls.Load())
+	}
+}
+
+func TestStartNonPositiveInterval(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic for a zero interval")
+		}
+	}()
+	Start(0, func() {})
+}
@@ -14,21 +14,41 @@ to the previous and next nodes.
 Key Features:
   - AddFirst / AddLast: Insert elements at the head or tail.
   - AddAt: Insert element at a specific index.
+  - AddSorted: Insert element at its position in a sorted list.
   - RemoveFirst / RemoveLast: Remove elements from head or tail.
   - Remove / RemoveAt: Remove by value or index.
   - PeekFirst / PeekLast: Read values at head/tail without removal.
   - Iterate: Channel-based iterator for easy traversal.
+  - IterateCtx: Channel-based iterator that stops when its context is cancelled.
+  - All / Backward: Range-over-func iterators (iter.Seq) supporting early break.
   - Contains / indexOf: Check if an element exists or get its index.
   - Clear: Reset the list.
+  - NewLinkedListFromSlice / AddAll / ToSlice: Bulk construction and export under a single lock.
+  - Sort: Stable in-place merge sort of the nodes.
+  - RemoveIf: Remove all elements matching a predicate in one traversal.
+  - ForEach / Filter / Map / Reduce: Functional-style processing of the elements.
+  - PushFront / PushBack / InsertAfter / InsertBefore: Insert and return a node handle.
+  - RemoveElement / MoveToFront / MoveToBack: O(1) operations on a node handle.
+  - SubList: Copy a positional range into a new list.
+  - Swap / Move: Reorder elements by relinking nodes.
+  - LRUCache: A least-recently-used cache built on the list and node handles.
+  - DrainTo / FillFrom: Bulk transfer to and from channels with a single lock acquisition.
+  - ListIterator: Bidirectional cursor with Set / Remove / Add at its position.
 
 Concurrency:
   - All public methods are protected with RWMutex for safe concurrent access.
+  - NewUnsafeLinkedList creates a list that skips locking, for single-goroutine use.
+  - Exported methods acquire the lock exactly once and delegate to unexported helpers
+    (pushBack, unlink, nodeAt, ...) that assume the lock is held, so no method locks
+    twice or touches the list without holding the lock.
 
 Algorithms:
   - Insertion at head/tail: Create a new ListNode and adjust prev/next pointers.
   - Deletion by value or index: Traverse list to locate node, then relink
     neighbors to exclude the node.
   - Iteration: Channel-based iteration reads nodes sequentially under read lock.
+  - All / Backward: Walk the nodes from head (or tail) under read lock, releasing it
+    as soon as the loop ends or breaks.
 
 Time Complexities:
   - AddFirst / AddLast: O(1)
@@ -37,11 +57,14 @@ Time Complexities:
   - PeekFirst / PeekLast: O(1)
   - Contains / indexOf: O(n)
   - Iterate: O(n)
+  - Sort: O(n log n) time, O(1) extra space
 */
 package linkedlist
 
 import (
+	"context"
 	"errors"
+	"iter"
 	"sync"
 )
 
@@ -49,9 +72,18 @@ import (
 type Iterator[T any] <-chan T
 
 // ListNode represents a node in a doubly linked list.
+//
+// Nodes returned by PushFront, PushBack, InsertAfter, InsertBefore, Front and Back
+// act as handles for O(1) positional operations such as RemoveElement or MoveToFront.
 type ListNode[T comparable] struct {
 	val        T
 	next, prev *ListNode[T]
+	list       *DoublyLinkedList[T] // the list the node belongs to, nil once removed
+}
+
+// Value returns the value stored in the node.
+func (n *ListNode[T]) Value() T {
+	return n.val
 }
 
 // NewListNode creates a new node with the given value.
@@ -68,6 +100,7 @@ type DoublyLinkedList[T comparable] struct {
 	size       int
 	head, tail *ListNode[T]
 	mutex      sync.RWMutex
+	unsafe     bool // true if the list skips locking (see NewUnsafeLinkedList)
 }
 
 // NewLinkedList initializes and returns a new empty doubly linked list.
@@ -75,18 +108,95 @@ func NewLinkedList[T comparable]() *DoublyLinkedList[T] {
 	return &DoublyLinkedList[T]{size: 0}
 }
 
+// NewUnsafeLinkedList initializes and returns a new empty doubly linked list that
+// does not lock its mutex.
+//
+// It offers the same API as a list created by NewLinkedList but avoids the locking
+// overhead, which dominates the cost of cheap operations such as AddLast in tight
+// single-goroutine loops. It must not be accessed by multiple goroutines concurrently
+// without external synchronization.
+func NewUnsafeLinkedList[T comparable]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{unsafe: true}
+}
+
+// lock acquires the write lock unless the list is unsafe.
+func (dl *DoublyLinkedList[T]) lock() {
+	if !dl.unsafe {
+		dl.mutex.Lock()
+	}
+}
+
+// unlock releases the write lock unless the list is unsafe.
+func (dl *DoublyLinkedList[T]) unlock() {
+	if !dl.unsafe {
+		dl.mutex.Unlock()
+	}
+}
+
+// rlock acquires the read lock unless the list is unsafe.
+func (dl *DoublyLinkedList[T]) rlock() {
+	if !dl.unsafe {
+		dl.mutex.RLock()
+	}
+}
+
+// runlock releases the read lock unless the list is unsafe.
+func (dl *DoublyLinkedList[T]) runlock() {
+	if !dl.unsafe {
+		dl.mutex.RUnlock()
+	}
+}
+
+// NewLinkedListFromSlice creates a doubly linked list holding the elements of vals
+// in order.
+//
+// Time Complexity: O(n)
+func NewLinkedListFromSlice[T comparable](vals []T) *DoublyLinkedList[T] {
+	dl := NewLinkedList[T]()
+	for _, v := range vals {
+		dl.pushBack(v)
+	}
+	return dl
+}
+
+// AddAll appends all given elements to the end of the list, in order, under a single
+// lock acquisition.
+//
+// Time Complexity: O(k), where k = number of elements added
+func (dl *DoublyLinkedList[T]) AddAll(vals ...T) {
+	dl.lock()
+	defer dl.unlock()
+	for _, v := range vals {
+		dl.pushBack(v)
+	}
+}
+
+// ToSlice returns a snapshot of the elements of the list, from head to tail.
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) ToSlice() []T {
+	dl.rlock()
+	defer dl.runlock()
+	result := make([]T, 0, dl.size)
+	for node := dl.head; node != nil; node = node.next {
+		result = append(result, node.val)
+	}
+	return result
+}
+
 // Clear removes all elements from the list and resets it to an empty state.
 // Algorithm: Traverse each node, disconnecting prev and next references.
 //
 // Time Complexity: O(n)
 func (dl *DoublyLinkedList[T]) Clear() {
-	dl.mutex.Lock()
-	defer dl.mutex.Unlock()
+	dl.lock()
+	defer dl.unlock()
 	iter := dl.head
 	for iter != nil {
 		next := iter.next
 		iter.prev = nil
 		iter.next = nil
+		iter.list = nil
 		iter = next
 	}
 	dl.head = nil
@@ -96,15 +206,15 @@ func (dl *DoublyLinkedList[T]) Clear() {
 
 // Size returns the number of elements in the list. O(1)
 func (dl *DoublyLinkedList[T]) Size() int {
-	dl.mutex.RLock()
-	defer dl.mutex.RUnlock()
+	dl.rlock()
+	defer dl.runlock()
 	return dl.size
 }
 
 // IsEmpty checks if the linked list is empty. O(1)
 func (dl *DoublyLinkedList[T]) IsEmpty() bool {
-	dl.mutex.RLock()
-	defer dl.mutex.RUnlock()
+	dl.rlock()
+	defer dl.runlock()
 	return dl.size == 0
 }
 
@@ -118,68 +228,92 @@ func (dl *DoublyLinkedList[T]) Add(elem T) (bool, error) {
 //
 // Time Complexity: O(1)
 func (dl *DoublyLinkedList[T]) AddLast(elem T) (bool, error) {
-	dl.mutex.Lock()
-	defer dl.mutex.Unlock()
+	dl.lock()
+	defer dl.unlock()
+	dl.pushBack(elem)
+	return true, nil
+}
+
+// pushBack links a new node holding elem after the tail. The caller must hold the write lock.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) pushBack(elem T) *ListNode[T] {
+	node := NewListNode(elem, dl.tail, nil)
+	node.list = dl
 	if dl.size == 0 {
-		node := NewListNode(elem, nil, nil)
 		dl.head = node
-		dl.tail = node
 	} else {
-		node := NewListNode(elem, dl.tail, nil)
 		dl.tail.next = node
-		dl.tail = dl.tail.next
 	}
+	dl.tail = node
 	dl.size++
-	return true, nil
+	return node
 }
 
-// AddFirst inserts a new element at the head of the list. O(1)
-func (dl *DoublyLinkedList[T]) AddFirst(elem T) (bool, error) {
-	dl.mutex.Lock()
-	defer dl.mutex.Unlock()
+// pushFront links a new node holding elem before the head. The caller must hold the write lock.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) pushFront(elem T) *ListNode[T] {
+	node := NewListNode(elem, nil, dl.head)
+	node.list = dl
 	if dl.size == 0 {
-		node := NewListNode(elem, nil, nil)
-		dl.head = node
 		dl.tail = node
 	} else {
-		node := NewListNode(elem, nil, dl.head)
 		dl.head.prev = node
-		dl.head = dl.head.prev
 	}
+	dl.head = node
 	dl.size++
+	return node
+}
+
+// AddFirst inserts a new element at the head of the list. O(1)
+func (dl *DoublyLinkedList[T]) AddFirst(elem T) (bool, error) {
+	dl.lock()
+	defer dl.unlock()
+	dl.pushFront(elem)
 	return true, nil
 }
 
 // AddAt inserts an element at a specific index in the list.
-// Algorithm: Traverse to index, link a new node between prev and next nodes.
+// Algorithm: Walk from the closer end to the node currently at index, link a new node before it.
 //
 // Time Complexity: O(n)
 func (dl *DoublyLinkedList[T]) AddAt(idx int, elem T) (bool, error) {
+	dl.lock()
+	defer dl.unlock()
 	if idx < 0 || idx > dl.size {
 		return false, errors.New("invalid index")
 	}
-	if idx == 0 {
-		return dl.AddFirst(elem)
-	}
-	if idx == dl.size {
-		return dl.AddLast(elem)
+	var mark *ListNode[T]
+	if idx < dl.size {
+		mark = dl.nodeAt(idx)
 	}
-	temp := dl.head
+	dl.linkBefore(mark, NewListNode(elem, nil, nil))
+	return true, nil
+}
 
-	for i := 0; i < idx-1; i++ {
-		temp = temp.next
+// AddSorted inserts elem into a list that is sorted according to less, keeping it
+// sorted. Equal elements keep their insertion order: elem is placed after them.
+//
+// Algorithm: Walk from the head to the first element that elem sorts before, link a
+// new node before it (or at the tail if there is none).
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) AddSorted(elem T, less func(a, b T) bool) (bool, error) {
+	dl.lock()
+	defer dl.unlock()
+	mark := dl.head
+	for mark != nil && !less(elem, mark.val) {
+		mark = mark.next
 	}
-	node := NewListNode(elem, temp, temp.next)
-	temp.next = node
-	node.next.prev = node
-	dl.size++
+	dl.linkBefore(mark, NewListNode(elem, nil, nil))
 	return true, nil
 }
 
 // PeekFirst returns the value of the first element. O(1)
 func (dl *DoublyLinkedList[T]) PeekFirst() (T, error) {
-	dl.mutex.RLock()
-	defer dl.mutex.RUnlock()
+	dl.rlock()
+	defer dl.runlock()
 	var zero T
 	if dl.size == 0 {
 		return zero, errors.New("linked list empty")
@@ -189,8 +323,8 @@ func (dl *DoublyLinkedList[T]) PeekFirst() (T, error) {
 
 // PeekLast returns the value of the last element. O(1)
 func (dl *DoublyLinkedList[T]) PeekLast() (T, error) {
-	dl.mutex.RLock()
-	defer dl.mutex.RUnlock()
+	dl.rlock()
+	defer dl.runlock()
 	var zero T
 	if dl.size == 0 {
 		return zero, errors.New("linked list empty")
@@ -203,61 +337,37 @@ func (dl *DoublyLinkedList[T]) PeekLast() (T, error) {
 //
 // Time Complexity: O(1)
 func (dl *DoublyLinkedList[T]) RemoveFirst() (T, error) {
-	dl.mutex.Lock()
-	defer dl.mutex.Unlock()
+	dl.lock()
+	defer dl.unlock()
 	var zero T
 	if dl.size == 0 {
 		return zero, errors.New("linked list empty")
 	}
-	value := dl.head.val
-	dl.head = dl.head.next
-	dl.size--
-	if dl.size == 0 {
-		dl.tail = nil
-	} else {
-		dl.head.prev = nil
-	}
-	return value, nil
+	return dl.removeNode(dl.head), nil
 }
 
 // RemoveLast removes and returns the last element. O(1)
 func (dl *DoublyLinkedList[T]) RemoveLast() (T, error) {
-	dl.mutex.Lock()
-	defer dl.mutex.Unlock()
+	dl.lock()
+	defer dl.unlock()
 	var zero T
 	if dl.size == 0 {
 		return zero, errors.New("linked list empty")
 	}
-	value := dl.tail.val
-	dl.tail = dl.tail.prev
-	dl.size--
-	if dl.size == 0 {
-		dl.head = nil
-	} else {
-		dl.tail.next = nil
-	}
-	return value, nil
+	return dl.removeNode(dl.tail), nil
 }
 
-// removeNode deletes a given node from the list and relink neighbors. O(1)
-func (dl *DoublyLinkedList[T]) removeNode(node *ListNode[T]) (T, error) {
-	if node.prev == nil {
-		return dl.RemoveFirst()
-	}
-	if node.next == nil {
-		return dl.RemoveLast()
-	}
-	node.next.prev = node.prev
-	node.prev.next = node.next
-	result := node.val
-	node.prev = nil
-	node.next = nil
-	dl.size--
-	return result, nil
+// removeNode deletes a given node from the list, relinks its neighbors and returns
+// its value. The caller must hold the write lock. O(1)
+func (dl *DoublyLinkedList[T]) removeNode(node *ListNode[T]) T {
+	dl.unlink(node)
+	return node.val
 }
 
 // Remove deletes the first occurrence of a given element. O(n)
 func (dl *DoublyLinkedList[T]) Remove(elem T) (T, error) {
+	dl.lock()
+	defer dl.unlock()
 	var zero T
 	if dl.size == 0 {
 		return zero, errors.New("linked list empty")
@@ -265,39 +375,42 @@ func (dl *DoublyLinkedList[T]) Remove(elem T) (T, error) {
 
 	for traveler := dl.head; traveler != nil; traveler = traveler.next {
 		if traveler.val == elem {
-			return dl.removeNode(traveler)
+			return dl.removeNode(traveler), nil
 		}
 	}
 	return zero, errors.New("value not found")
 }
 
-// RemoveAt removes and returns the element at a specific index. O(n)
+// RemoveAt removes and returns the element at a specific index.
+// Algorithm: Walk from the closer end to the index, then unlink the node.
+//
+// Time Complexity: O(n)
 func (dl *DoublyLinkedList[T]) RemoveAt(idx int) (T, error) {
+	dl.lock()
+	defer dl.unlock()
 	var zero T
 	if idx < 0 || idx >= dl.size {
 		return zero, errors.New("invalid index")
 	}
+	return dl.removeNode(dl.nodeAt(idx)), nil
+}
 
-	var traveler *ListNode[T]
-	if idx < dl.size/2 {
-		traveler = dl.head
-		for i := 0; i < idx; i++ {
-			traveler = traveler.next
-		}
-	} else {
-		traveler = dl.tail
-		for i := dl.size - 1; i > idx; i-- {
-			traveler = traveler.prev
-		}
+// Get returns the element at a specific index without removing it.
+// Algorithm: Walk from the closer end to the index.
+//
+// Time Complexity: O(min(idx, n-idx))
+func (dl *DoublyLinkedList[T]) Get(idx int) (T, error) {
+	dl.rlock()
+	defer dl.runlock()
+	var zero T
+	if idx < 0 || idx >= dl.size {
+		return zero, errors.New("invalid index")
 	}
-
-	return dl.removeNode(traveler)
+	return dl.nodeAt(idx).val, nil
 }
 
-// indexOf finds the index of an element in the list. O(n)
+// indexOf finds the index of an element in the list. The caller must hold the lock. O(n)
 func (dl *DoublyLinkedList[T]) indexOf(elem T) (int, error) {
-	dl.mutex.RLock()
-	defer dl.mutex.RUnlock()
 	if dl.size == 0 {
 		return -1, errors.New("linked list empty")
 	}
@@ -316,6 +429,8 @@ func (dl *DoublyLinkedList[T]) indexOf(elem T) (int, error) {
 
 // Contains checks if an element exists in the list. O(n)
 func (dl *DoublyLinkedList[T]) Contains(elem T) (bool, error) {
+	dl.rlock()
+	defer dl.runlock()
 	result, err := dl.indexOf(elem)
 	if err != nil {
 		return false, err
@@ -323,12 +438,51 @@ func (dl *DoublyLinkedList[T]) Contains(elem T) (bool, error) {
 	return result >= 0, nil
 }
 
+// IterateCtx returns a channel-based iterator for traversing the list that stops
+// when ctx is cancelled.
+//
+// The producing goroutine holds the read lock while it sends; cancelling ctx makes it
+// close the channel and release the lock even if the consumer abandoned the channel.
+// Consumers that stop early should therefore always cancel ctx.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range list.IterateCtx(ctx) {
+//	    if v == target {
+//	        break // cancel releases the producer
+//	    }
+//	}
+//
+// Time Complexity: O(n) for a full traversal
+func (dl *DoublyLinkedList[T]) IterateCtx(ctx context.Context) Iterator[T] {
+	iterChan := make(chan T)
+	go func() {
+		dl.rlock()
+		defer dl.runlock()
+		defer close(iterChan)
+		for node := dl.head; node != nil; node = node.next {
+			select {
+			case iterChan <- node.val:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return iterChan
+}
+
 // Iterate returns a channel-based iterator for traversing the list.
+//
+// The channel must be drained: a consumer that stops early leaves the producing
+// goroutine blocked while holding the read lock, which blocks all writers. Prefer
+// All for in-process loops or IterateCtx when early cancellation is needed.
 func (dl *DoublyLinkedList[T]) Iterate() Iterator[T] {
 	iterChan := make(chan T)
 	go func() {
-		dl.mutex.RLock()
-		defer dl.mutex.RUnlock()
+		dl.rlock()
+		defer dl.runlock()
 		defer close(iterChan)
 		iterNode := dl.head
 		for iterNode != nil {
@@ -338,3 +492,564 @@ func (dl *DoublyLinkedList[T]) Iterate() Iterator[T] {
 	}()
 	return iterChan
 }
+
+// All returns an iterator over the elements of the list, from head to tail.
+//
+// The read lock is held for the duration of the loop and released as soon as the
+// loop finishes or breaks, so unlike Iterate no goroutine is left behind. The loop
+// body must not modify the list, since that would deadlock.
+//
+// Example:
+//
+//	for v := range list.All() {
+//	    if v == target {
+//	        break
+//	    }
+//	}
+//
+// Time Complexity: O(n) for a full traversal
+func (dl *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dl.rlock()
+		defer dl.runlock()
+		for node := dl.head; node != nil; node = node.next {
+			if !yield(node.val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the list, from tail to head.
+//
+// The same locking rules as for All apply.
+//
+// Time Complexity: O(n) for a full traversal
+func (dl *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dl.rlock()
+		defer dl.runlock()
+		for node := dl.tail; node != nil; node = node.prev {
+			if !yield(node.val) {
+				return
+			}
+		}
+	}
+}
+
+// Sort sorts the list in place according to less, relinking the nodes rather than
+// copying values. The sort is stable: equal elements keep their relative order.
+//
+// Algorithm Steps (bottom-up merge sort):
+//   - Treat the list as runs of width 1.
+//   - Repeatedly split off two adjacent runs of the current width and merge them
+//     onto the end of the output chain, following next pointers only.
+//   - Double the width until a pass performs a single merge.
+//   - Restore the prev pointers and the tail in a final pass.
+//
+// Time Complexity: O(n log n)
+//
+// Space Complexity: O(1)
+func (dl *DoublyLinkedList[T]) Sort(less func(a, b T) bool) {
+	dl.lock()
+	defer dl.unlock()
+	if dl.size < 2 {
+		return
+	}
+	head := dl.head
+	for width := 1; ; width *= 2 {
+		var newHead, newTail *ListNode[T]
+		merges := 0
+		for p := head; p != nil; {
+			merges++
+			left := p
+			right := split(left, width)
+			p = split(right, width)
+			first, last := merge(left, right, less)
+			if newTail == nil {
+				newHead = first
+			} else {
+				newTail.next = first
+			}
+			newTail = last
+		}
+		head = newHead
+		if merges <= 1 {
+			break
+		}
+	}
+
+	var prev *ListNode[T]
+	for node := head; node != nil; node = node.next {
+		node.prev = prev
+		prev = node
+	}
+	dl.head = head
+	dl.tail = prev
+}
+
+// split cuts the chain after its first n nodes and returns the rest (or nil).
+//
+// Time Complexity: O(n)
+func split[T comparable](node *ListNode[T], n int) *ListNode[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// merge merges two sorted nil-terminated chains and returns the first and last node
+// of the result. Nodes of a are taken first on ties, which keeps the sort stable.
+//
+// Time Complexity: O(len(a) + len(b))
+func merge[T comparable](a, b *ListNode[T], less func(a, b T) bool) (first, last *ListNode[T]) {
+	var dummy ListNode[T]
+	tail := &dummy
+	for a != nil && b != nil {
+		if less(b.val, a.val) {
+			tail.next = b
+			b = b.next
+		} else {
+			tail.next = a
+			a = a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	for tail.next != nil {
+		tail = tail.next
+	}
+	return dummy.next, tail
+}
+
+// unlink detaches node from the list and fixes head, tail and size.
+// The caller must hold the write lock.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) unlink(node *ListNode[T]) {
+	if node.prev == nil {
+		dl.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		dl.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.prev = nil
+	node.next = nil
+	node.list = nil
+	dl.size--
+}
+
+// RemoveIf removes every element for which pred returns true, in a single traversal
+// under one lock acquisition, and returns the number of removed elements.
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) RemoveIf(pred func(T) bool) int {
+	dl.lock()
+	defer dl.unlock()
+	removed := 0
+	for node := dl.head; node != nil; {
+		next := node.next
+		if pred(node.val) {
+			dl.unlink(node)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// ForEach calls fn for every element of the list, from head to tail.
+//
+// The read lock is held while fn runs, so fn must not modify the list.
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) ForEach(fn func(T)) {
+	dl.rlock()
+	defer dl.runlock()
+	for node := dl.head; node != nil; node = node.next {
+		fn(node.val)
+	}
+}
+
+// Filter returns a new list holding, in order, the elements for which pred returns true.
+// The original list is not modified.
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) Filter(pred func(T) bool) *DoublyLinkedList[T] {
+	dl.rlock()
+	defer dl.runlock()
+	result := NewLinkedList[T]()
+	for node := dl.head; node != nil; node = node.next {
+		if pred(node.val) {
+			result.pushBack(node.val)
+		}
+	}
+	return result
+}
+
+// Map returns a new list holding fn applied to every element of dl, in order.
+//
+// It is a function rather than a method because Go methods cannot introduce the
+// result type parameter U.
+//
+// Example:
+//
+//	lengths := linkedlist.Map(words, func(s string) int { return len(s) })
+//
+// Time Complexity: O(n)
+func Map[T, U comparable](dl *DoublyLinkedList[T], fn func(T) U) *DoublyLinkedList[U] {
+	dl.rlock()
+	defer dl.runlock()
+	result := NewLinkedList[U]()
+	for node := dl.head; node != nil; node = node.next {
+		result.pushBack(fn(node.val))
+	}
+	return result
+}
+
+// Reduce folds the elements of dl from head to tail into a single value, starting
+// from initial and combining with fn.
+//
+// Example:
+//
+//	sum := linkedlist.Reduce(nums, 0, func(acc, v int) int { return acc + v })
+//
+// Time Complexity: O(n)
+func Reduce[T comparable, A any](dl *DoublyLinkedList[T], initial A, fn func(A, T) A) A {
+	dl.rlock()
+	defer dl.runlock()
+	acc := initial
+	for node := dl.head; node != nil; node = node.next {
+		acc = fn(acc, node.val)
+	}
+	return acc
+}
+
+// errNotInList is returned when a node handle does not belong to the list.
+var errNotInList = errors.New("node not in list")
+
+// PushFront inserts elem at the head of the list and returns its node handle.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) PushFront(elem T) *ListNode[T] {
+	dl.lock()
+	defer dl.unlock()
+	return dl.pushFront(elem)
+}
+
+// PushBack inserts elem at the tail of the list and returns its node handle.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) PushBack(elem T) *ListNode[T] {
+	dl.lock()
+	defer dl.unlock()
+	return dl.pushBack(elem)
+}
+
+// Front returns the node handle of the first element, or nil if the list is empty.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) Front() *ListNode[T] {
+	dl.rlock()
+	defer dl.runlock()
+	return dl.head
+}
+
+// Back returns the node handle of the last element, or nil if the list is empty.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) Back() *ListNode[T] {
+	dl.rlock()
+	defer dl.runlock()
+	return dl.tail
+}
+
+// InsertAfter inserts elem right after the node mark and returns the new node handle.
+//
+// Returns an error if mark does not belong to the list.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) InsertAfter(mark *ListNode[T], elem T) (*ListNode[T], error) {
+	dl.lock()
+	defer dl.unlock()
+	if mark == nil || mark.list != dl {
+		return nil, errNotInList
+	}
+	if mark == dl.tail {
+		return dl.pushBack(elem), nil
+	}
+	node := NewListNode(elem, mark, mark.next)
+	node.list = dl
+	mark.next.prev = node
+	mark.next = node
+	dl.size++
+	return node, nil
+}
+
+// InsertBefore inserts elem right before the node mark and returns the new node handle.
+//
+// Returns an error if mark does not belong to the list.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) InsertBefore(mark *ListNode[T], elem T) (*ListNode[T], error) {
+	dl.lock()
+	defer dl.unlock()
+	if mark == nil || mark.list != dl {
+		return nil, errNotInList
+	}
+	if mark == dl.head {
+		return dl.pushFront(elem), nil
+	}
+	node := NewListNode(elem, mark.prev, mark)
+	node.list = dl
+	mark.prev.next = node
+	mark.prev = node
+	dl.size++
+	return node, nil
+}
+
+// RemoveElement removes the node from the list and returns its value.
+//
+// Returns an error if node does not belong to the list, e.g. because it was
+// already removed.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) RemoveElement(node *ListNode[T]) (T, error) {
+	dl.lock()
+	defer dl.unlock()
+	var zero T
+	if node == nil || node.list != dl {
+		return zero, errNotInList
+	}
+	dl.unlink(node)
+	return node.val, nil
+}
+
+// MoveToFront moves the node to the head of the list without reallocating it, so the
+// handle stays valid.
+//
+// Returns an error if node does not belong to the list.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) MoveToFront(node *ListNode[T]) error {
+	dl.lock()
+	defer dl.unlock()
+	if node == nil || node.list != dl {
+		return errNotInList
+	}
+	if node == dl.head {
+		return nil
+	}
+	dl.unlink(node)
+	dl.linkBefore(dl.head, node)
+	return nil
+}
+
+// MoveToBack moves the node to the tail of the list without reallocating it, so the
+// handle stays valid.
+//
+// Returns an error if node does not belong to the list.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) MoveToBack(node *ListNode[T]) error {
+	dl.lock()
+	defer dl.unlock()
+	if node == nil || node.list != dl {
+		return errNotInList
+	}
+	if node == dl.tail {
+		return nil
+	}
+	dl.unlink(node)
+	dl.linkBefore(nil, node)
+	return nil
+}
+
+// nodeAt returns the node at index idx, walking from whichever end is closer.
+// The caller must hold the lock and ensure 0 <= idx < size.
+//
+// Time Complexity: O(min(idx, n-idx))
+func (dl *DoublyLinkedList[T]) nodeAt(idx int) *ListNode[T] {
+	if idx < dl.size/2 {
+		node := dl.head
+		for i := 0; i < idx; i++ {
+			node = node.next
+		}
+		return node
+	}
+	node := dl.tail
+	for i := dl.size - 1; i > idx; i-- {
+		node = node.prev
+	}
+	return node
+}
+
+// SubList returns a new list holding a copy of the elements at positions from
+// (inclusive) to to (exclusive). The original list is not modified.
+//
+// Returns an error unless 0 <= from <= to <= Size().
+//
+// Example:
+//
+//	// third page of 20 items
+//	page, err := list.SubList(40, min(60, list.Size()))
+//
+// Time Complexity: O(min(from, n-from) + (to-from))
+func (dl *DoublyLinkedList[T]) SubList(from, to int) (*DoublyLinkedList[T], error) {
+	dl.rlock()
+	defer dl.runlock()
+	if from < 0 || to > dl.size || from > to {
+		return nil, errors.New("invalid index")
+	}
+	result := NewLinkedList[T]()
+	if from == to {
+		return result, nil
+	}
+	node := dl.nodeAt(from)
+	for i := from; i < to; i++ {
+		result.pushBack(node.val)
+		node = node.next
+	}
+	return result, nil
+}
+
+// linkBefore links the detached node right before mark, or at the tail if mark is nil.
+// The caller must hold the write lock.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) linkBefore(mark, node *ListNode[T]) {
+	node.list = dl
+	node.next = mark
+	if mark == nil {
+		node.prev = dl.tail
+		dl.tail = node
+	} else {
+		node.prev = mark.prev
+		mark.prev = node
+	}
+	if node.prev == nil {
+		dl.head = node
+	} else {
+		node.prev.next = node
+	}
+	dl.size++
+}
+
+// Swap exchanges the elements at positions i and j by relinking their nodes, so node
+// handles follow their values.
+//
+// Returns an error if either index is out of range.
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) Swap(i, j int) error {
+	dl.lock()
+	defer dl.unlock()
+	if i < 0 || i >= dl.size || j < 0 || j >= dl.size {
+		return errors.New("invalid index")
+	}
+	if i == j {
+		return nil
+	}
+	if i > j {
+		i, j = j, i
+	}
+	a, b := dl.nodeAt(i), dl.nodeAt(j)
+	aNext, bNext := a.next, b.next
+	dl.unlink(b)
+	dl.linkBefore(a, b)
+	if aNext != b {
+		dl.unlink(a)
+		dl.linkBefore(bNext, a)
+	}
+	return nil
+}
+
+// Move moves the element at position from so that it ends up at position to, shifting
+// the elements in between by one. The node is relinked rather than copied.
+//
+// Returns an error if either index is out of range.
+//
+// Example:
+//
+//	// playlist [a b c d]: drag "d" to the top
+//	list.Move(3, 0) // [d a b c]
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) Move(from, to int) error {
+	dl.lock()
+	defer dl.unlock()
+	if from < 0 || from >= dl.size || to < 0 || to >= dl.size {
+		return errors.New("invalid index")
+	}
+	if from == to {
+		return nil
+	}
+	node := dl.nodeAt(from)
+	dl.unlink(node)
+	var mark *ListNode[T]
+	if to < dl.size {
+		mark = dl.nodeAt(to)
+	}
+	dl.linkBefore(mark, node)
+	return nil
+}
+
+// DrainTo removes all elements from the list and sends them to ch in order, returning
+// the number of elements sent.
+//
+// The elements are detached under a single lock acquisition, which also invalidates
+// their node handles, and sent after the lock is released, so a slow consumer does not
+// block other users of the list. DrainTo blocks until every element has been sent.
+//
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList[T]) DrainTo(ch chan<- T) int {
+	dl.lock()
+	vals := make([]T, 0, dl.size)
+	for node := dl.head; node != nil; {
+		next := node.next
+		vals = append(vals, node.val)
+		node.prev, node.next, node.list = nil, nil, nil
+		node = next
+	}
+	dl.head, dl.tail, dl.size = nil, nil, 0
+	dl.unlock()
+
+	for _, v := range vals {
+		ch <- v
+	}
+	return len(vals)
+}
+
+// FillFrom receives values from ch and appends them to the list, returning the number
+// of values added. It stops after limit values, or when ch is closed; a limit <= 0
+// means no limit.
+//
+// Values are buffered while receiving and appended under a single lock acquisition,
+// so the list is not locked while waiting on the channel.
+//
+// Time Complexity: O(k), where k = number of values received
+func (dl *DoublyLinkedList[T]) FillFrom(ch <-chan T, limit int) int {
+	var batch []T
+	for limit <= 0 || len(batch) < limit {
+		v, ok := <-ch
+		if !ok {
+			break
+		}
+		batch = append(batch, v)
+	}
+	dl.AddAll(batch...)
+	return len(batch)
+}
@@ -70,3 +70,21 @@ func BenchmarkLinkedListRemoveFirstParallel(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkLinkedListAddAll(b *testing.B) {
+	vals := make([]int, 1000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dl := NewLinkedList[int]()
+		dl.AddAll(vals...)
+	}
+}
+
+func BenchmarkUnsafeLinkedListAddLast(b *testing.B) {
+	dl := NewUnsafeLinkedList[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = dl.AddLast(i)
+	}
+}
@@ -1,7 +1,12 @@
 package linkedlist
 
 import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestAddAndSize(t *testing.T) {
@@ -340,3 +345,519 @@ func TestRemoveNode_LastNode(t *testing.T) {
 		t.Errorf("Expected last element to be 20, got %d", last)
 	}
 }
+
+func TestAllAndBackward(t *testing.T) {
+	list := NewLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		_, _ = list.Add(i)
+	}
+
+	var forward []int
+	for v := range list.All() {
+		forward = append(forward, v)
+	}
+	if !reflect.DeepEqual(forward, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("All() = %v", forward)
+	}
+
+	var backward []int
+	for v := range list.Backward() {
+		backward = append(backward, v)
+	}
+	if !reflect.DeepEqual(backward, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("Backward() = %v", backward)
+	}
+
+	// breaking out early releases the read lock
+	for v := range list.All() {
+		if v == 2 {
+			break
+		}
+	}
+	if _, err := list.AddLast(6); err != nil || list.Size() != 6 {
+		t.Errorf("expected list to be writable after early break")
+	}
+
+	for range NewLinkedList[int]().All() {
+		t.Errorf("expected no elements in empty list")
+	}
+}
+
+func TestFromSliceAddAllToSlice(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{1, 2, 3})
+	if list.Size() != 3 {
+		t.Fatalf("Expected size 3, got %d", list.Size())
+	}
+	list.AddAll(4, 5)
+	list.AddAll()
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+	if last, _ := list.PeekLast(); last != 5 {
+		t.Errorf("Expected last element 5, got %d", last)
+	}
+
+	empty := NewLinkedListFromSlice[int](nil)
+	if !empty.IsEmpty() || len(empty.ToSlice()) != 0 {
+		t.Errorf("Expected empty list")
+	}
+	empty.AddAll(7)
+	if first, _ := empty.PeekFirst(); first != 7 {
+		t.Errorf("Expected first element 7, got %d", first)
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := [][]int{
+		{},
+		{1},
+		{2, 1},
+		{5, 3, 9, 1, 5, 7, 2, 8, 0, 4, 6},
+		{1, 2, 3, 4, 5, 6, 7, 8},
+		{8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	for _, vals := range tests {
+		list := NewLinkedListFromSlice(vals)
+		list.Sort(func(a, b int) bool { return a < b })
+
+		expected := slices.Sorted(slices.Values(vals))
+		if got := list.ToSlice(); !slices.Equal(got, expected) {
+			t.Errorf("Sort(%v) = %v; want %v", vals, got, expected)
+		}
+		var backward []int
+		for v := range list.Backward() {
+			backward = append([]int{v}, backward...)
+		}
+		if !slices.Equal(backward, expected) {
+			t.Errorf("prev links broken after Sort(%v): %v", vals, backward)
+		}
+		if list.Size() != len(vals) {
+			t.Errorf("Expected size %d, got %d", len(vals), list.Size())
+		}
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	type item struct{ key, order int }
+	list := NewLinkedListFromSlice([]item{{2, 0}, {1, 1}, {2, 2}, {1, 3}, {0, 4}, {2, 5}})
+	list.Sort(func(a, b item) bool { return a.key < b.key })
+	expected := []item{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 5}}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Sort() = %v; want %v", got, expected)
+	}
+	if _, err := list.AddLast(item{9, 9}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if last, _ := list.PeekLast(); last != (item{9, 9}) {
+		t.Errorf("tail not updated after Sort")
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{2, 1, 4, 3, 6, 5, 8})
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	if n := list.RemoveIf(isEven); n != 4 {
+		t.Errorf("Expected 4 removals, got %d", n)
+	}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+	first, _ := list.PeekFirst()
+	last, _ := list.PeekLast()
+	if first != 1 || last != 5 || list.Size() != 3 {
+		t.Errorf("unexpected head %d, tail %d or size %d", first, last, list.Size())
+	}
+	if n := list.RemoveIf(isEven); n != 0 {
+		t.Errorf("Expected no removals, got %d", n)
+	}
+	if n := list.RemoveIf(func(int) bool { return true }); n != 3 || !list.IsEmpty() {
+		t.Errorf("Expected list to be emptied, removed %d", n)
+	}
+	if _, err := list.AddLast(9); err != nil || list.Size() != 1 {
+		t.Errorf("Expected list to be reusable after RemoveIf")
+	}
+}
+
+func TestFunctionalHelpers(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{1, 2, 3, 4, 5})
+
+	var seen []int
+	list.ForEach(func(v int) { seen = append(seen, v) })
+	if !reflect.DeepEqual(seen, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("ForEach visited %v", seen)
+	}
+
+	odd := list.Filter(func(v int) bool { return v%2 == 1 })
+	if got := odd.ToSlice(); !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Errorf("Filter() = %v", got)
+	}
+	if list.Size() != 5 {
+		t.Errorf("Filter should not modify the original list")
+	}
+
+	labels := Map(list, func(v int) string { return string(rune('a' + v - 1)) })
+	if got := labels.ToSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Map() = %v", got)
+	}
+
+	if sum := Reduce(list, 0, func(acc, v int) int { return acc + v }); sum != 15 {
+		t.Errorf("Reduce() = %d; want 15", sum)
+	}
+	if got := Reduce(NewLinkedList[int](), "init", func(acc string, _ int) string { return acc + "!" }); got != "init" {
+		t.Errorf("Reduce on empty list = %q; want init", got)
+	}
+}
+
+func TestNodeHandles(t *testing.T) {
+	list := NewLinkedList[string]()
+	b := list.PushBack("b")
+	a := list.PushFront("a")
+	d := list.PushBack("d")
+	c, err := list.InsertBefore(d, "c")
+	if err != nil {
+		t.Fatalf("InsertBefore: %v", err)
+	}
+	e, _ := list.InsertAfter(d, "e")
+	if _, err := list.InsertAfter(a, "a2"); err != nil {
+		t.Fatalf("InsertAfter: %v", err)
+	}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []string{"a", "a2", "b", "c", "d", "e"}) {
+		t.Fatalf("ToSlice() = %v", got)
+	}
+	if list.Front() != a || list.Back() != e || c.Value() != "c" {
+		t.Errorf("unexpected front/back handles")
+	}
+
+	if err := list.MoveToFront(d); err != nil {
+		t.Errorf("MoveToFront: %v", err)
+	}
+	if err := list.MoveToBack(a); err != nil {
+		t.Errorf("MoveToBack: %v", err)
+	}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []string{"d", "a2", "b", "c", "e", "a"}) {
+		t.Errorf("after moves ToSlice() = %v", got)
+	}
+	var backward []string
+	for v := range list.Backward() {
+		backward = append(backward, v)
+	}
+	if !reflect.DeepEqual(backward, []string{"a", "e", "c", "b", "a2", "d"}) {
+		t.Errorf("prev links broken: %v", backward)
+	}
+
+	if v, err := list.RemoveElement(b); err != nil || v != "b" {
+		t.Errorf("RemoveElement(b) = %q, %v", v, err)
+	}
+	if _, err := list.RemoveElement(b); err == nil {
+		t.Errorf("Expected error when removing a node twice")
+	}
+	if err := list.MoveToFront(b); err == nil {
+		t.Errorf("Expected error when moving a removed node")
+	}
+
+	// handles of nodes removed through other methods are invalidated as well
+	if _, err := list.RemoveFirst(); err != nil {
+		t.Fatalf("RemoveFirst: %v", err)
+	}
+	if _, err := list.RemoveElement(d); err == nil {
+		t.Errorf("Expected error for a node removed by RemoveFirst")
+	}
+
+	other := NewLinkedList[string]()
+	if _, err := other.InsertAfter(c, "x"); err == nil {
+		t.Errorf("Expected error for a node of another list")
+	}
+	if _, err := list.InsertBefore(nil, "x"); err == nil {
+		t.Errorf("Expected error for a nil node")
+	}
+	if list.Size() != 4 {
+		t.Errorf("Expected size 4, got %d", list.Size())
+	}
+}
+
+func TestSubList(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	tests := []struct {
+		from, to int
+		expected []int
+	}{
+		{0, 3, []int{0, 1, 2}},
+		{7, 10, []int{7, 8, 9}},
+		{4, 6, []int{4, 5}},
+		{0, 10, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{5, 5, []int{}},
+	}
+	for _, tt := range tests {
+		sub, err := list.SubList(tt.from, tt.to)
+		if err != nil {
+			t.Errorf("SubList(%d, %d) returned error %v", tt.from, tt.to, err)
+			continue
+		}
+		if got := sub.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("SubList(%d, %d) = %v; want %v", tt.from, tt.to, got, tt.expected)
+		}
+	}
+
+	for _, r := range [][2]int{{-1, 2}, {3, 11}, {6, 5}} {
+		if _, err := list.SubList(r[0], r[1]); err == nil {
+			t.Errorf("Expected error for SubList(%d, %d)", r[0], r[1])
+		}
+	}
+
+	sub, _ := list.SubList(0, 2)
+	_, _ = sub.AddLast(100)
+	if list.Size() != 10 {
+		t.Errorf("modifying a sublist should not affect the original")
+	}
+}
+
+func TestIterateCtx(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{1, 2, 3, 4, 5})
+
+	var got []int
+	for v := range list.IterateCtx(context.Background()) {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("IterateCtx() = %v", got)
+	}
+
+	// abandoning the channel and cancelling releases the read lock
+	ctx, cancel := context.WithCancel(context.Background())
+	for v := range list.IterateCtx(ctx) {
+		if v == 2 {
+			break
+		}
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = list.AddLast(6)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("writer blocked after cancelling IterateCtx")
+	}
+	if list.Size() != 6 {
+		t.Errorf("Expected size 6, got %d", list.Size())
+	}
+}
+
+func TestSwap(t *testing.T) {
+	tests := []struct {
+		i, j     int
+		expected []int
+	}{
+		{0, 4, []int{4, 1, 2, 3, 0}},
+		{1, 2, []int{0, 2, 1, 3, 4}},
+		{3, 1, []int{0, 3, 2, 1, 4}},
+		{2, 2, []int{0, 1, 2, 3, 4}},
+		{4, 3, []int{0, 1, 2, 4, 3}},
+	}
+	for _, tt := range tests {
+		list := NewLinkedListFromSlice([]int{0, 1, 2, 3, 4})
+		if err := list.Swap(tt.i, tt.j); err != nil {
+			t.Errorf("Swap(%d, %d) returned error %v", tt.i, tt.j, err)
+		}
+		if got := list.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Swap(%d, %d) = %v; want %v", tt.i, tt.j, got, tt.expected)
+		}
+		backward := slices.Collect(list.Backward())
+		slices.Reverse(backward)
+		if !reflect.DeepEqual(backward, tt.expected) {
+			t.Errorf("prev links broken after Swap(%d, %d): %v", tt.i, tt.j, backward)
+		}
+	}
+
+	list := NewLinkedListFromSlice([]int{1, 2})
+	if list.Swap(-1, 0) == nil || list.Swap(0, 2) == nil {
+		t.Errorf("Expected error for out-of-range index")
+	}
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		from, to int
+		expected []string
+	}{
+		{3, 0, []string{"d", "a", "b", "c"}},
+		{0, 3, []string{"b", "c", "d", "a"}},
+		{1, 2, []string{"a", "c", "b", "d"}},
+		{2, 1, []string{"a", "c", "b", "d"}},
+		{1, 1, []string{"a", "b", "c", "d"}},
+	}
+	for _, tt := range tests {
+		list := NewLinkedListFromSlice([]string{"a", "b", "c", "d"})
+		if err := list.Move(tt.from, tt.to); err != nil {
+			t.Errorf("Move(%d, %d) returned error %v", tt.from, tt.to, err)
+		}
+		if got := list.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Move(%d, %d) = %v; want %v", tt.from, tt.to, got, tt.expected)
+		}
+		backward := slices.Collect(list.Backward())
+		slices.Reverse(backward)
+		if !reflect.DeepEqual(backward, tt.expected) || list.Size() != 4 {
+			t.Errorf("prev links broken after Move(%d, %d): %v", tt.from, tt.to, backward)
+		}
+	}
+
+	list := NewLinkedListFromSlice([]string{"a"})
+	if list.Move(0, 1) == nil || list.Move(1, 0) == nil {
+		t.Errorf("Expected error for out-of-range index")
+	}
+}
+
+func TestConcurrentPositionalOperations(t *testing.T) {
+	list := NewLinkedList[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				_, _ = list.AddAt(0, g*1000+i)
+				_, _ = list.AddAt(list.Size()/2, i)
+				_, _ = list.RemoveAt(0)
+				_, _ = list.Remove(i)
+				_, _ = list.Contains(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	size := list.Size()
+	if got := len(list.ToSlice()); got != size {
+		t.Errorf("size %d does not match %d linked elements", size, got)
+	}
+	if got := len(slices.Collect(list.Backward())); got != size {
+		t.Errorf("size %d does not match %d backward-linked elements", size, got)
+	}
+}
+
+func TestUnsafeLinkedList(t *testing.T) {
+	list := NewUnsafeLinkedList[int]()
+	list.AddAll(3, 1, 2)
+	_, _ = list.AddFirst(0)
+	list.Sort(func(a, b int) bool { return a < b })
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+	if v, err := list.RemoveAt(1); err != nil || v != 1 {
+		t.Errorf("RemoveAt(1) = %d, %v", v, err)
+	}
+	if ok, _ := list.Contains(3); !ok {
+		t.Errorf("Expected list to contain 3")
+	}
+	if Map(list, func(v int) int { return v * 2 }).Size() != 3 {
+		t.Errorf("Map on unsafe list returned wrong size")
+	}
+}
+
+func TestAddSorted(t *testing.T) {
+	type timer struct {
+		due int
+		id  string
+	}
+	byDue := func(a, b timer) bool { return a.due < b.due }
+	list := NewLinkedList[timer]()
+	for _, tm := range []timer{{5, "a"}, {1, "b"}, {9, "c"}, {5, "d"}, {0, "e"}, {9, "f"}} {
+		if ok, err := list.AddSorted(tm, byDue); !ok || err != nil {
+			t.Fatalf("AddSorted(%v) = %v, %v", tm, ok, err)
+		}
+	}
+	expected := []timer{{0, "e"}, {1, "b"}, {5, "a"}, {5, "d"}, {9, "c"}, {9, "f"}}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ToSlice() = %v; want %v", got, expected)
+	}
+	if last, _ := list.PeekLast(); last != (timer{9, "f"}) {
+		t.Errorf("Expected tail {9 f}, got %v", last)
+	}
+	backward := slices.Collect(list.Backward())
+	slices.Reverse(backward)
+	if !reflect.DeepEqual(backward, expected) {
+		t.Errorf("prev links broken: %v", backward)
+	}
+}
+
+func TestDrainToAndFillFrom(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{1, 2, 3})
+	handle := list.Back()
+	ch := make(chan int, 3)
+	if n := list.DrainTo(ch); n != 3 {
+		t.Errorf("DrainTo() = %d; want 3", n)
+	}
+	close(ch)
+	if !list.IsEmpty() {
+		t.Errorf("Expected list to be empty after DrainTo")
+	}
+	if _, err := list.RemoveElement(handle); err == nil {
+		t.Errorf("Expected drained node handle to be invalid")
+	}
+
+	other := NewLinkedList[int]()
+	if n := other.FillFrom(ch, 0); n != 3 {
+		t.Errorf("FillFrom() = %d; want 3", n)
+	}
+	if got := other.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+
+	src := make(chan int, 5)
+	for i := 10; i < 15; i++ {
+		src <- i
+	}
+	if n := other.FillFrom(src, 2); n != 2 {
+		t.Errorf("FillFrom(limit 2) = %d; want 2", n)
+	}
+	if got := other.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3, 10, 11}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+}
+
+func TestDrainToInvalidatesHandlesBeforeSending(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{1, 2, 3})
+	last := list.Back()
+	ch := make(chan int)
+	done := make(chan int)
+	go func() { done <- list.DrainTo(ch) }()
+
+	// DrainTo is now blocked on the unbuffered send of the first element
+	if v := <-ch; v != 1 {
+		t.Fatalf("Expected 1 first, got %d", v)
+	}
+	if _, err := list.RemoveElement(last); err == nil {
+		t.Errorf("Expected a handle to an unsent node to be invalid during the drain")
+	}
+	if err := list.MoveToFront(last); err == nil {
+		t.Errorf("Expected MoveToFront on an unsent node to fail during the drain")
+	}
+	if _, err := list.InsertAfter(last, 9); err == nil {
+		t.Errorf("Expected InsertAfter on an unsent node to fail during the drain")
+	}
+	got := []int{1, <-ch, <-ch}
+	if n := <-done; n != 3 || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("DrainTo() = %d sending %v; want 3 sending [1 2 3]", n, got)
+	}
+	if list.Size() != 0 || len(list.ToSlice()) != 0 {
+		t.Errorf("Expected empty list, got size %d", list.Size())
+	}
+}
+
+func TestGet(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{10, 20, 30, 40, 50})
+	for i, want := range []int{10, 20, 30, 40, 50} {
+		if got, err := list.Get(i); err != nil || got != want {
+			t.Errorf("Get(%d) = %d, %v; want %d", i, got, err, want)
+		}
+	}
+	for _, idx := range []int{-1, 5} {
+		if _, err := list.Get(idx); err == nil {
+			t.Errorf("Expected error for Get(%d)", idx)
+		}
+	}
+}
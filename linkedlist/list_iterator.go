@@ -0,0 +1,191 @@
+package linkedlist
+
+import "errors"
+
+// ListIterator is a bidirectional cursor over a DoublyLinkedList that can modify the
+// list at its position, like Java's ListIterator.
+//
+// The cursor always lies between two elements (or before the first / after the last).
+// Next and Prev move the cursor and return the element they step over; Set and Remove
+// then act on that element, and Add inserts right before the cursor. Every operation
+// is O(1), so editing passes over large lists avoid re-traversing by index.
+//
+// Each method acquires the list's lock on its own; the iterator does not hold the lock
+// between calls. Structural changes made to the list by others while iterating (other
+// than through this iterator) leave the cursor in an unspecified position.
+// A ListIterator itself must not be shared between goroutines.
+//
+// Fields:
+//   - list: the list being iterated
+//   - next: the element after the cursor, nil at the end of the list
+//   - last: the element returned by the latest Next or Prev, nil if there is none
+//   - index: the index of next
+//
+// Example:
+//
+//	it := list.ListIterator()
+//	for it.HasNext() {
+//	    v, _ := it.Next()
+//	    switch {
+//	    case v < 0:
+//	        _ = it.Remove()
+//	    case v == 0:
+//	        _ = it.Set(1)
+//	    }
+//	}
+type ListIterator[T comparable] struct {
+	list  *DoublyLinkedList[T]
+	next  *ListNode[T]
+	last  *ListNode[T]
+	index int
+}
+
+// ListIterator returns an iterator positioned before the first element of the list.
+//
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList[T]) ListIterator() *ListIterator[T] {
+	dl.rlock()
+	defer dl.runlock()
+	return &ListIterator[T]{list: dl, next: dl.head}
+}
+
+// ListIteratorAt returns an iterator positioned before the element at index idx;
+// idx == Size() positions it after the last element, ready for backward traversal.
+//
+// Returns an error if idx is out of range.
+//
+// Time Complexity: O(min(idx, n-idx))
+func (dl *DoublyLinkedList[T]) ListIteratorAt(idx int) (*ListIterator[T], error) {
+	dl.rlock()
+	defer dl.runlock()
+	if idx < 0 || idx > dl.size {
+		return nil, errors.New("invalid index")
+	}
+	it := &ListIterator[T]{list: dl, index: idx}
+	if idx < dl.size {
+		it.next = dl.nodeAt(idx)
+	}
+	return it, nil
+}
+
+// HasNext reports whether there is an element after the cursor.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) HasNext() bool {
+	it.list.rlock()
+	defer it.list.runlock()
+	return it.next != nil
+}
+
+// HasPrev reports whether there is an element before the cursor.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) HasPrev() bool {
+	it.list.rlock()
+	defer it.list.runlock()
+	return it.prevNode() != nil
+}
+
+// prevNode returns the element before the cursor. The caller must hold the lock.
+func (it *ListIterator[T]) prevNode() *ListNode[T] {
+	if it.next == nil {
+		return it.list.tail
+	}
+	return it.next.prev
+}
+
+// NextIndex returns the index of the element after the cursor (Size() at the end).
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) NextIndex() int {
+	return it.index
+}
+
+// Next moves the cursor forward and returns the element it stepped over.
+//
+// Returns an error if the cursor is at the end of the list.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) Next() (T, error) {
+	it.list.rlock()
+	defer it.list.runlock()
+	var zero T
+	if it.next == nil {
+		return zero, errors.New("no next element")
+	}
+	it.last = it.next
+	it.next = it.next.next
+	it.index++
+	return it.last.val, nil
+}
+
+// Prev moves the cursor backward and returns the element it stepped over.
+//
+// Returns an error if the cursor is at the start of the list.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) Prev() (T, error) {
+	it.list.rlock()
+	defer it.list.runlock()
+	var zero T
+	prev := it.prevNode()
+	if prev == nil {
+		return zero, errors.New("no previous element")
+	}
+	it.next = prev
+	it.last = prev
+	it.index--
+	return prev.val, nil
+}
+
+// Set replaces the element returned by the latest Next or Prev.
+//
+// Returns an error if there is no such element, e.g. because Remove or Add was called
+// since.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) Set(elem T) error {
+	it.list.lock()
+	defer it.list.unlock()
+	if it.last == nil || it.last.list != it.list {
+		return errors.New("no current element")
+	}
+	it.last.val = elem
+	return nil
+}
+
+// Remove removes the element returned by the latest Next or Prev from the list.
+//
+// Returns an error if there is no such element, e.g. because Remove or Add was called
+// since.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) Remove() error {
+	it.list.lock()
+	defer it.list.unlock()
+	if it.last == nil || it.last.list != it.list {
+		return errors.New("no current element")
+	}
+	if it.last == it.next {
+		// returned by Prev: the cursor stays before the following element
+		it.next = it.next.next
+	} else {
+		// returned by Next: the element was before the cursor
+		it.index--
+	}
+	it.list.unlink(it.last)
+	it.last = nil
+	return nil
+}
+
+// Add inserts elem right before the cursor, so a following Next is unaffected and a
+// following Prev returns elem.
+//
+// Time Complexity: O(1)
+func (it *ListIterator[T]) Add(elem T) {
+	it.list.lock()
+	defer it.list.unlock()
+	it.list.linkBefore(it.next, NewListNode(elem, nil, nil))
+	it.index++
+	it.last = nil
+}
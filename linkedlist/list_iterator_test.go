@@ -0,0 +1,113 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListIteratorEditingPass(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{-1, 0, 2, -3, 4})
+	it := list.ListIterator()
+	for it.HasNext() {
+		v, err := it.Next()
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		switch {
+		case v < 0:
+			if err := it.Remove(); err != nil {
+				t.Errorf("Remove: %v", err)
+			}
+		case v == 0:
+			if err := it.Set(1); err != nil {
+				t.Errorf("Set: %v", err)
+			}
+		default:
+			it.Add(v * 10)
+		}
+	}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 20, 4, 40}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+	if it.NextIndex() != list.Size() {
+		t.Errorf("NextIndex() = %d; want %d", it.NextIndex(), list.Size())
+	}
+	if _, err := it.Next(); err == nil {
+		t.Errorf("Expected error at the end of the list")
+	}
+}
+
+func TestListIteratorBackward(t *testing.T) {
+	list := NewLinkedListFromSlice([]string{"a", "b", "c"})
+	it, err := list.ListIteratorAt(list.Size())
+	if err != nil {
+		t.Fatalf("ListIteratorAt: %v", err)
+	}
+
+	var got []string
+	for it.HasPrev() {
+		v, _ := it.Prev()
+		got = append(got, v)
+		if v == "b" {
+			if err := it.Remove(); err != nil {
+				t.Errorf("Remove: %v", err)
+			}
+		}
+	}
+	if !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+		t.Errorf("backward traversal = %v", got)
+	}
+	if it.NextIndex() != 0 {
+		t.Errorf("NextIndex() = %d; want 0", it.NextIndex())
+	}
+	if _, err := it.Prev(); err == nil {
+		t.Errorf("Expected error at the start of the list")
+	}
+
+	// Add at the start, then step forward over the existing elements
+	it.Add("_")
+	if v, _ := it.Next(); v != "a" {
+		t.Errorf("Next() after Add = %q; want a", v)
+	}
+	if v, _ := it.Prev(); v != "a" {
+		t.Errorf("Prev() = %q; want a", v)
+	}
+	if v, _ := it.Prev(); v != "_" {
+		t.Errorf("Prev() = %q; want _", v)
+	}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []string{"_", "a", "c"}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+}
+
+func TestListIteratorInvalidState(t *testing.T) {
+	list := NewLinkedListFromSlice([]int{1, 2})
+	it := list.ListIterator()
+	if it.Set(5) == nil || it.Remove() == nil {
+		t.Errorf("Expected errors before the first Next")
+	}
+	_, _ = it.Next()
+	_ = it.Remove()
+	if it.Remove() == nil || it.Set(5) == nil {
+		t.Errorf("Expected errors after Remove")
+	}
+	_, _ = it.Next()
+	it.Add(3)
+	if it.Set(5) == nil {
+		t.Errorf("Expected error after Add")
+	}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("ToSlice() = %v", got)
+	}
+
+	for _, idx := range []int{-1, 3} {
+		if _, err := list.ListIteratorAt(idx); err == nil {
+			t.Errorf("Expected error for ListIteratorAt(%d)", idx)
+		}
+	}
+	if it, _ := list.ListIteratorAt(1); it.NextIndex() != 1 {
+		t.Errorf("Expected NextIndex 1")
+	} else if v, _ := it.Next(); v != 3 {
+		t.Errorf("Next() = %d; want 3", v)
+	}
+}
@@ -0,0 +1,159 @@
+package linkedlist
+
+import "sync"
+
+// lruEntry is a key/value pair stored in the recency list of an LRUCache.
+// The list holds pointers because V is not required to be comparable.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRUCache is a thread-safe, fixed-capacity cache that evicts the least recently used
+// entry when full.
+//
+// It combines a DoublyLinkedList ordered by recency (most recent at the head) with a
+// map from keys to node handles, so Get, Put and Remove all run in O(1).
+// cache.LRU builds on it to add per-entry expiry and hit/miss statistics.
+//
+// Fields:
+//   - capacity: the maximum number of entries
+//   - items: maps each key to its node in the recency list
+//   - order: the recency list; it is unsynchronized because the cache's mutex guards it
+//   - onEvict: optional callback invoked for every entry evicted due to capacity
+//   - mutex: a mutex guarding the cache (Get updates recency, so it needs the write lock)
+//
+// Example:
+//
+//	cache := linkedlist.NewLRUCache[string, int](2, func(k string, v int) {
+//	    fmt.Println("evicted", k)
+//	})
+//	cache.Put("a", 1)
+//	cache.Put("b", 2)
+//	cache.Get("a")    // "a" becomes the most recently used entry
+//	cache.Put("c", 3) // prints "evicted b"
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*ListNode[*lruEntry[K, V]]
+	order    *DoublyLinkedList[*lruEntry[K, V]]
+	onEvict  func(K, V)
+	mutex    sync.Mutex
+}
+
+// NewLRUCache creates an empty LRUCache holding at most capacity entries.
+//
+// onEvict, if not nil, is called with every entry evicted to make room for a new one.
+// It is called after the cache's lock is released, so it may use the cache.
+// A capacity below 1 is treated as 1.
+func NewLRUCache[K comparable, V any](capacity int, onEvict func(K, V)) *LRUCache[K, V] {
+	capacity = max(capacity, 1)
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*ListNode[*lruEntry[K, V]], capacity),
+		order:    NewUnsafeLinkedList[*lruEntry[K, V]](),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value stored for key and marks the entry as most recently used.
+//
+// Time Complexity: O(1)
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	node, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	_ = c.order.MoveToFront(node)
+	return node.val.value, true
+}
+
+// Peek returns the value stored for key without updating its recency.
+//
+// Time Complexity: O(1)
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	node, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.val.value, true
+}
+
+// Put stores value for key and marks the entry as most recently used. If the cache is
+// full and key is new, the least recently used entry is evicted first.
+//
+// Returns true if an entry was evicted.
+//
+// Time Complexity: O(1)
+func (c *LRUCache[K, V]) Put(key K, value V) bool {
+	c.mutex.Lock()
+	if node, ok := c.items[key]; ok {
+		node.val.value = value
+		_ = c.order.MoveToFront(node)
+		c.mutex.Unlock()
+		return false
+	}
+	var evicted *lruEntry[K, V]
+	if len(c.items) >= c.capacity {
+		evicted, _ = c.order.RemoveElement(c.order.Back())
+		delete(c.items, evicted.key)
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key, value})
+	c.mutex.Unlock()
+
+	if evicted != nil && c.onEvict != nil {
+		c.onEvict(evicted.key, evicted.value)
+	}
+	return evicted != nil
+}
+
+// Remove deletes the entry for key. The eviction callback is not called.
+//
+// Returns true if the entry existed.
+//
+// Time Complexity: O(1)
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	_, _ = c.order.RemoveElement(node)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns the number of entries in the cache.
+//
+// Time Complexity: O(1)
+func (c *LRUCache[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+//
+// Time Complexity: O(1)
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Keys returns the keys of the cache from most to least recently used.
+//
+// Time Complexity: O(n)
+func (c *LRUCache[K, V]) Keys() []K {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	keys := make([]K, 0, len(c.items))
+	for e := range c.order.All() {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
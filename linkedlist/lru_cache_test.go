@@ -0,0 +1,91 @@
+package linkedlist
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestLRUCacheEviction(t *testing.T) {
+	var evicted []string
+	cache := NewLRUCache[string, int](2, func(k string, v int) {
+		evicted = append(evicted, k)
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(a) = %d, %v; want 1, true", v, ok)
+	}
+	if !cache.Put("c", 3) {
+		t.Errorf("Expected Put(c) to evict")
+	}
+	if !reflect.DeepEqual(evicted, []string{"b"}) {
+		t.Errorf("evicted = %v; want [b]", evicted)
+	}
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if got := cache.Keys(); !reflect.DeepEqual(got, []string{"c", "a"}) {
+		t.Errorf("Keys() = %v; want [c a]", got)
+	}
+
+	// updating an existing key refreshes it without evicting
+	if cache.Put("a", 10) {
+		t.Errorf("Expected update not to evict")
+	}
+	cache.Put("d", 4)
+	if !reflect.DeepEqual(evicted, []string{"b", "c"}) {
+		t.Errorf("evicted = %v; want [b c]", evicted)
+	}
+	if v, _ := cache.Peek("a"); v != 10 {
+		t.Errorf("Peek(a) = %d; want 10", v)
+	}
+	if cache.Len() != 2 || cache.Capacity() != 2 {
+		t.Errorf("Len() = %d, Capacity() = %d", cache.Len(), cache.Capacity())
+	}
+}
+
+func TestLRUCachePeekAndRemove(t *testing.T) {
+	cache := NewLRUCache[int, []byte](2, nil)
+	cache.Put(1, []byte("one"))
+	cache.Put(2, []byte("two"))
+
+	// Peek does not refresh recency, so 1 is still evicted first
+	if _, ok := cache.Peek(1); !ok {
+		t.Errorf("Expected Peek(1) to find the entry")
+	}
+	cache.Put(3, []byte("three"))
+	if _, ok := cache.Peek(1); ok {
+		t.Errorf("Expected 1 to be evicted")
+	}
+
+	if !cache.Remove(2) || cache.Remove(2) {
+		t.Errorf("Remove(2) returned unexpected result")
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Expected length 1, got %d", cache.Len())
+	}
+	if NewLRUCache[int, int](0, nil).Capacity() != 1 {
+		t.Errorf("Expected capacity below 1 to be treated as 1")
+	}
+}
+
+func TestLRUCacheConcurrent(t *testing.T) {
+	cache := NewLRUCache[int, int](64, nil)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				cache.Put(g*1000+i, i)
+				cache.Get(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if cache.Len() != 64 || len(cache.Keys()) != 64 {
+		t.Errorf("Expected 64 entries, got %d", cache.Len())
+	}
+}
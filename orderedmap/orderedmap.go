@@ -0,0 +1,64 @@
+/*
+Package orderedmap defines OrderedMap, the method set shared by the sorted maps of this
+module, so code can be written once and run on whichever implementation suits the
+workload:
+
+  - avltree.AVLTree: strictly balanced binary tree, for read-dominated workloads.
+  - btree.BTree: cache-friendly B-tree, for large key counts.
+  - skiplist.SkipList: probabilistic skip list, which also answers rank queries.
+
+Example:
+
+	func oldest[V any](m orderedmap.OrderedMap[int, V], n int) []int {
+	    var keys []int
+	    for k := range m.All() {
+	        if len(keys) == n {
+	            break
+	        }
+	        keys = append(keys, k)
+	    }
+	    return keys
+	}
+
+	oldest[string](avltree.New[int, string](), 10)
+	oldest[string](btree.New[int, string](), 10)
+*/
+package orderedmap
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// OrderedMap is a map whose keys are kept in ascending order. Implementations are safe
+// for concurrent use.
+type OrderedMap[K constraints.Ordered, V any] interface {
+	// Put associates value with key and reports whether key was newly added.
+	Put(key K, value V) bool
+	// Get returns the value associated with key and whether key is present.
+	Get(key K) (V, bool)
+	// Remove deletes key and reports whether it was present.
+	Remove(key K) bool
+	// Contains reports whether key is present.
+	Contains(key K) bool
+	// Len returns the number of keys.
+	Len() int
+	// Clear removes all keys.
+	Clear()
+
+	// Min returns the smallest key and its value, or false if the map is empty.
+	Min() (K, V, bool)
+	// Max returns the largest key and its value, or false if the map is empty.
+	Max() (K, V, bool)
+	// Floor returns the largest key less than or equal to key, and its value.
+	Floor(key K) (K, V, bool)
+	// Ceiling returns the smallest key greater than or equal to key, and its value.
+	Ceiling(key K) (K, V, bool)
+
+	// All returns an iterator over the pairs in ascending key order.
+	All() iter.Seq2[K, V]
+	// Range returns an iterator over the pairs with from <= key < to in ascending key
+	// order.
+	Range(from, to K) iter.Seq2[K, V]
+}
@@ -0,0 +1,133 @@
+package orderedmap_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/Zubayear/ryushin/avltree"
+	"github.com/Zubayear/ryushin/btree"
+	"github.com/Zubayear/ryushin/orderedmap"
+	"github.com/Zubayear/ryushin/set"
+	"github.com/Zubayear/ryushin/skiplist"
+)
+
+// implementations lists a constructor for every OrderedMap in the module.
+var implementations = []struct {
+	name string
+	new  func() orderedmap.OrderedMap[int, int]
+}{
+	{"avltree", func() orderedmap.OrderedMap[int, int] { return avltree.New[int, int]() }},
+	{"btree", func() orderedmap.OrderedMap[int, int] { return btree.New[int, int]() }},
+	{"skiplist", func() orderedmap.OrderedMap[int, int] { return skiplist.New[int, int]() }},
+}
+
+func TestOrderedMapImplementations(t *testing.T) {
+	for _, impl := range implementations {
+		m := impl.new()
+		for _, k := range []int{40, 10, 30, 50, 20} {
+			m.Put(k, k/10)
+		}
+		if k, v, ok := m.Min(); !ok || k != 10 || v != 1 {
+			t.Errorf("%s: Min = %v, %v, %v; want 10, 1", impl.name, k, v, ok)
+		}
+		if k, _, ok := m.Max(); !ok || k != 50 {
+			t.Errorf("%s: Max = %v, %v; want 50", impl.name, k, ok)
+		}
+		if k, _, ok := m.Floor(35); !ok || k != 30 {
+			t.Errorf("%s: Floor(35) = %v, %v; want 30", impl.name, k, ok)
+		}
+		if k, _, ok := m.Ceiling(35); !ok || k != 40 {
+			t.Errorf("%s: Ceiling(35) = %v, %v; want 40", impl.name, k, ok)
+		}
+		if _, _, ok := m.Floor(5); ok {
+			t.Errorf("%s: Floor(5): expected false", impl.name)
+		}
+		if _, _, ok := m.Ceiling(55); ok {
+			t.Errorf("%s: Ceiling(55): expected false", impl.name)
+		}
+
+		var keys []int
+		for k := range m.Range(20, 50) {
+			keys = append(keys, k)
+		}
+		if !reflect.DeepEqual(keys, []int{20, 30, 40}) {
+			t.Errorf("%s: Range(20, 50) = %v; want [20 30 40]", impl.name, keys)
+		}
+		keys = keys[:0]
+		for k := range m.All() {
+			keys = append(keys, k)
+			if k == 30 {
+				break
+			}
+		}
+		if !reflect.DeepEqual(keys, []int{10, 20, 30}) {
+			t.Errorf("%s: All with early exit = %v; want [10 20 30]", impl.name, keys)
+		}
+
+		if !m.Remove(30) || m.Contains(30) || m.Len() != 4 {
+			t.Errorf("%s: Remove(30) failed", impl.name)
+		}
+		m.Clear()
+		if m.Len() != 0 {
+			t.Errorf("%s: expected empty map after Clear", impl.name)
+		}
+	}
+}
+
+const benchKeys = 1 << 16
+
+// benchPerm returns a fixed random permutation of [0, benchKeys).
+func benchPerm() []int {
+	return rand.New(rand.NewPCG(1, 1)).Perm(benchKeys)
+}
+
+// The module has no TreeMap, so set.OrderedSet, a red-black tree, is the baseline.
+
+func BenchmarkPut(b *testing.B) {
+	keys := benchPerm()
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			m := impl.new()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				m.Put(keys[i%benchKeys], i)
+			}
+		})
+	}
+	b.Run("rbtree", func(b *testing.B) {
+		s := set.NewOrderedSet[int]()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.Insert(keys[i%benchKeys])
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	keys := benchPerm()
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			m := impl.new()
+			for _, k := range keys {
+				m.Put(k, k)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.Get(keys[i%benchKeys])
+			}
+		})
+	}
+	b.Run("rbtree", func(b *testing.B) {
+		s := set.NewOrderedSet[int]()
+		for _, k := range keys {
+			s.Insert(k)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s.Contain(keys[i%benchKeys])
+		}
+	})
+}
@@ -17,6 +17,16 @@ Key Features:
   - IsEmpty: Check if the heap is empty (O(1)).
   - Size: Return the number of elements in the heap (O(1)).
   - Clear: Remove all elements from the heap (O(1)).
+  - PollIf: Remove the root only if it satisfies a predicate (O(log n)).
+  - PollRandomWeighted: Remove an element sampled proportionally to a weight (O(n)).
+  - Stats: Report current size, high-water mark and push/poll counters (O(1)).
+  - SetComparator: Swap the ordering function and re-heapify (O(n)).
+
+Variants:
+  - ShardedHeap: lock-striped priority queue for high-contention workloads,
+    polling the best root across independent shards.
+  - HeapAdapter: exposes an existing container/heap.Interface through the ryushin API.
+  - BinaryHeap.HeapInterface: exposes a BinaryHeap as a container/heap.Interface.
 
 Algorithm Notes:
   - Binary Heap is stored in a slice.
@@ -30,11 +40,16 @@ package priorityqueue
 
 import (
 	"errors"
+	"math/rand/v2"
 	"sync"
 
 	"golang.org/x/exp/constraints"
 )
 
+// ErrEmpty is returned when an element is requested from an empty heap.
+// Callers can test for it with errors.Is.
+var ErrEmpty = errors.New("heap empty")
+
 // BinaryHeap is a generic, thread-safe binary heap implementation.
 //
 // It supports both min-heap and max-heap behavior depending on the comparator
@@ -59,10 +74,28 @@ import (
 //   - cmp: comparator function used to maintain heap property
 //     (should return true if the first element has higher priority than the second)-
 //   - mutex: RWMutex to ensure safe concurrent access
+//   - maxSize, pushes, polls: lightweight instrumentation reported by Stats
 type BinaryHeap[T any] struct {
-	data  []T               // slice storing heap elements
-	cmp   func(a, b T) bool // comparator defining heap ordering
-	mutex sync.RWMutex      // protects heap for concurrent access
+	data    []T               // slice storing heap elements
+	cmp     func(a, b T) bool // comparator defining heap ordering
+	mutex   sync.RWMutex      // protects heap for concurrent access
+	maxSize int               // largest number of elements observed
+	pushes  uint64            // total number of inserted elements
+	polls   uint64            // total number of removed roots
+}
+
+// Stats is a point-in-time snapshot of heap instrumentation counters.
+//
+// Fields:
+//   - Size: current number of elements
+//   - MaxSize: high-water mark, the largest size observed since creation
+//   - Pushes: total number of elements added
+//   - Polls: total number of elements removed from the root
+type Stats struct {
+	Size    int
+	MaxSize int
+	Pushes  uint64
+	Polls   uint64
 }
 
 // NewBinaryHeap creates a new BinaryHeap instance using the natural ordering of T.
@@ -178,7 +211,7 @@ func (bh *BinaryHeap[T]) Size() int {
 //
 // Returns:
 //   - the root element
-//   - error if the heap is empty
+//   - ErrEmpty if the heap is empty
 //
 // Complexity: O(1)
 func (bh *BinaryHeap[T]) Peek() (T, error) {
@@ -186,7 +219,7 @@ func (bh *BinaryHeap[T]) Peek() (T, error) {
 	bh.mutex.RLock()
 	defer bh.mutex.RUnlock()
 	if len(bh.data) == 0 {
-		return zero, errors.New("heap empty")
+		return zero, ErrEmpty
 	}
 	return bh.data[0], nil
 }
@@ -196,7 +229,7 @@ func (bh *BinaryHeap[T]) Peek() (T, error) {
 //
 // Returns:
 //   - the root element
-//   - error if the heap is empty
+//   - ErrEmpty if the heap is empty
 //
 // Complexity: O(log n) due to re-heapification
 func (bh *BinaryHeap[T]) Poll() (T, error) {
@@ -204,11 +237,92 @@ func (bh *BinaryHeap[T]) Poll() (T, error) {
 	bh.mutex.Lock()
 	defer bh.mutex.Unlock()
 	if len(bh.data) == 0 {
-		return zero, errors.New("heap empty")
+		return zero, ErrEmpty
 	}
+	bh.polls++
 	return bh.removeAt(0) // we can only remove the root
 }
 
+// PollIf removes and returns the root element only if it satisfies the predicate.
+//
+// The check and the removal happen atomically under the write lock, which avoids
+// the race of calling Peek followed by Poll from multiple goroutines.
+//
+// Returns:
+//   - the root element and true if the heap is non-empty and pred(root) is true
+//   - the zero value and false otherwise; the heap is left unchanged
+//
+// Example usage:
+//
+//	// only take the next task if it is due
+//	task, ok := bh.PollIf(func(t Task) bool { return !t.Due.After(time.Now()) })
+//
+// Complexity: O(log n)
+func (bh *BinaryHeap[T]) PollIf(pred func(T) bool) (T, bool) {
+	var zero T
+	bh.mutex.Lock()
+	defer bh.mutex.Unlock()
+	if len(bh.data) == 0 || !pred(bh.data[0]) {
+		return zero, false
+	}
+	bh.polls++
+	v, _ := bh.removeAt(0)
+	return v, true
+}
+
+// PollRandomWeighted removes and returns an element sampled with probability
+// proportional to weight(element).
+//
+// This is useful for probabilistic schedulers that mostly take the top item but
+// occasionally pick lower-priority ones to avoid starvation, e.g. by giving the
+// root a large weight and the rest a small one.
+//
+// Notes:
+//   - Negative weights are treated as zero.
+//   - If all weights are zero, the root is removed as with Poll.
+//
+// Returns:
+//   - the sampled element
+//   - ErrEmpty if the heap is empty
+//
+// Complexity: O(n) to compute the weights, plus O(log n) to re-heapify
+func (bh *BinaryHeap[T]) PollRandomWeighted(weight func(T) float64) (T, error) {
+	var zero T
+	bh.mutex.Lock()
+	defer bh.mutex.Unlock()
+	if len(bh.data) == 0 {
+		return zero, ErrEmpty
+	}
+
+	weights := make([]float64, len(bh.data))
+	total := 0.0
+	for i, v := range bh.data {
+		w := weight(v)
+		if w > 0 {
+			weights[i] = w
+			total += w
+		}
+	}
+
+	idx := 0
+	if total > 0 {
+		target := rand.Float64() * total
+		for i, w := range weights {
+			if target < w {
+				idx = i
+				break
+			}
+			target -= w
+			// guard against floating point drift on the last positive weight
+			if w > 0 {
+				idx = i
+			}
+		}
+	}
+	bh.polls++
+	return bh.removeAt(idx)
+}
+
 // removeAt removes the element at index k from the heap and returns it.
 //
 // Steps:
@@ -221,22 +335,39 @@ func (bh *BinaryHeap[T]) Poll() (T, error) {
 //
 // Returns:
 //   - the removed element
-//   - error if the heap is empty
+//   - ErrEmpty if the heap is empty
 //
-// Note: This is an internal helper method, primarily used by Poll.
+// Note: This is an internal helper method, used by Poll and PollRandomWeighted.
 //
 // Complexity: O(log n)
 func (bh *BinaryHeap[T]) removeAt(k int) (T, error) {
 	size := len(bh.data)
 	if size == 0 {
 		var zero T
-		return zero, errors.New("heap empty")
+		return zero, ErrEmpty
 	}
 	removed := bh.data[k]
 	last := bh.data[size-1]
 	bh.data[k] = last
 	bh.data = bh.data[:size-1]
 
+	if k < len(bh.data) {
+		bh.sink(k)
+		// an element taken from the bottom may also need to move up
+		// when it replaces a node that is not the root
+		bh.swim(k)
+	}
+
+	return removed, nil
+}
+
+// sink moves the element at index k down the heap until the heap property is satisfied.
+//
+// At each step the child with higher priority (according to the comparator) is selected
+// and swapped with the parent if it violates the heap property.
+//
+// Complexity: O(log n)
+func (bh *BinaryHeap[T]) sink(k int) {
 	parent := k
 	child := 2*parent + 1
 	for child < len(bh.data) {
@@ -257,8 +388,36 @@ func (bh *BinaryHeap[T]) removeAt(k int) (T, error) {
 			break
 		}
 	}
+}
 
-	return removed, nil
+// heapify rebuilds the heap property over the whole slice using bottom-up construction.
+//
+// Algorithm: sink every non-leaf node, starting from the last parent up to the root.
+//
+// Complexity: O(n)
+func (bh *BinaryHeap[T]) heapify() {
+	for i := len(bh.data)/2 - 1; i >= 0; i-- {
+		bh.sink(i)
+	}
+}
+
+// SetComparator replaces the ordering function of the heap and re-heapifies
+// the existing elements so that the heap property holds for the new comparator.
+//
+// Parameters:
+//   - cmp: should return true if element `a` has higher priority than `b`
+//
+// Example usage:
+//
+//	bh := NewBinaryHeap[int]() // max-heap
+//	bh.SetComparator(func(a, b int) bool { return a < b }) // now a min-heap
+//
+// Complexity: O(n)
+func (bh *BinaryHeap[T]) SetComparator(cmp func(a, b T) bool) {
+	bh.mutex.Lock()
+	defer bh.mutex.Unlock()
+	bh.cmp = cmp
+	bh.heapify()
 }
 
 // Add inserts a new element into the heap and restores the heap property.
@@ -271,10 +430,43 @@ func (bh *BinaryHeap[T]) Add(val T) {
 	bh.mutex.Lock()
 	defer bh.mutex.Unlock()
 	bh.data = append(bh.data, val)
+	bh.recordPush()
 	idxOfLastElem := len(bh.data) - 1
 	bh.swim(idxOfLastElem)
 }
 
+// recordPush updates the push counter and the high-water mark after an insertion.
+//
+// Complexity: O(1)
+func (bh *BinaryHeap[T]) recordPush() {
+	bh.pushes++
+	if len(bh.data) > bh.maxSize {
+		bh.maxSize = len(bh.data)
+	}
+}
+
+// Stats returns a snapshot of the heap's instrumentation counters: the current size,
+// the maximum size observed, and the total number of pushes and polls.
+//
+// Clear does not reset the counters, so they describe the whole lifetime of the heap.
+//
+// Example usage:
+//
+//	st := bh.Stats()
+//	fmt.Printf("size=%d peak=%d in=%d out=%d\n", st.Size, st.MaxSize, st.Pushes, st.Polls)
+//
+// Complexity: O(1)
+func (bh *BinaryHeap[T]) Stats() Stats {
+	bh.mutex.RLock()
+	defer bh.mutex.RUnlock()
+	return Stats{
+		Size:    len(bh.data),
+		MaxSize: bh.maxSize,
+		Pushes:  bh.pushes,
+		Polls:   bh.polls,
+	}
+}
+
 // Swap exchanges the elements at indexes i and j.
 //
 // Complexity: O(1)
@@ -44,13 +44,13 @@ func TestBinaryHeapOperations(t *testing.T) {
 	}
 
 	_, err := bh.Peek()
-	if errors.Is(err, errors.New("heap empty")) {
-		t.Errorf("Expected %v, got %v\n", errors.New("heap empty"), err)
+	if !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected %v, got %v\n", ErrEmpty, err)
 	}
 
 	_, err = bh.Poll()
-	if errors.Is(err, errors.New("heap empty")) {
-		t.Errorf("Expected %v, got %v\n", errors.New("heap empty"), err)
+	if !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected %v, got %v\n", ErrEmpty, err)
 	}
 }
 
@@ -470,7 +470,134 @@ func TestBinaryHeapConcurrencyIssue(t *testing.T) {
 func TestBinaryHeapRemoveInEmptyHeap(t *testing.T) {
 	bh := NewBinaryHeap[int]()
 	_, err := bh.removeAt(1)
-	if errors.Is(err, errors.New("heap empty")) {
-		t.Errorf("Expected heap empty error")
+	if !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected heap empty error, got %v", err)
+	}
+}
+
+func TestBinaryHeapSetComparator(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	for _, v := range []int{7, 3, 9, 1, 5, 8, 2} {
+		bh.Add(v)
+	}
+	if top, _ := bh.Peek(); top != 9 {
+		t.Fatalf("Expected max-heap root 9, got %d", top)
+	}
+
+	bh.SetComparator(func(a, b int) bool { return a < b })
+	expected := []int{1, 2, 3, 5, 7, 8, 9}
+	if result := bh.Sort(); !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v after switching comparator, got %v", expected, result)
+	}
+	for _, exp := range expected {
+		if v, err := bh.Poll(); err != nil || v != exp {
+			t.Errorf("Poll expected %d, got %d err=%v", exp, v, err)
+		}
+	}
+
+	bh.SetComparator(func(a, b int) bool { return a > b })
+	if !bh.IsEmpty() {
+		t.Errorf("Expected empty heap")
+	}
+}
+
+func TestBinaryHeapPollIf(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	if _, ok := bh.PollIf(func(int) bool { return true }); ok {
+		t.Errorf("Expected PollIf on empty heap to return false")
+	}
+
+	for _, v := range []int{10, 30, 20} {
+		bh.Add(v)
+	}
+
+	if _, ok := bh.PollIf(func(v int) bool { return v < 25 }); ok {
+		t.Errorf("Expected PollIf to reject root 30")
+	}
+	if bh.Size() != 3 {
+		t.Errorf("Expected size 3 after rejected PollIf, got %d", bh.Size())
+	}
+
+	v, ok := bh.PollIf(func(v int) bool { return v >= 25 })
+	if !ok || v != 30 {
+		t.Errorf("Expected PollIf to return 30, got %d ok=%v", v, ok)
+	}
+	if top, _ := bh.Peek(); top != 20 {
+		t.Errorf("Expected new root 20, got %d", top)
+	}
+}
+
+func TestBinaryHeapStats(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	if st := bh.Stats(); st != (Stats{}) {
+		t.Fatalf("Expected zero stats, got %+v", st)
+	}
+
+	for i := 0; i < 5; i++ {
+		bh.Add(i)
+	}
+	_, _ = bh.Poll()
+	_, _ = bh.Poll()
+	_, _ = bh.PollIf(func(int) bool { return false })
+	bh.Add(10)
+
+	expected := Stats{Size: 4, MaxSize: 5, Pushes: 6, Polls: 2}
+	if st := bh.Stats(); st != expected {
+		t.Errorf("Expected %+v, got %+v", expected, st)
+	}
+
+	bh.Clear()
+	expected.Size = 0
+	if st := bh.Stats(); st != expected {
+		t.Errorf("Expected %+v after Clear, got %+v", expected, st)
+	}
+}
+
+func TestBinaryHeapPollRandomWeighted(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	if _, err := bh.PollRandomWeighted(func(int) float64 { return 1 }); err == nil {
+		t.Errorf("Expected error on empty heap")
+	}
+
+	for i := 1; i <= 20; i++ {
+		bh.Add(i)
+	}
+
+	// only the value 7 has a positive weight, so it must be selected
+	v, err := bh.PollRandomWeighted(func(v int) float64 {
+		if v == 7 {
+			return 1
+		}
+		return 0
+	})
+	if err != nil || v != 7 {
+		t.Errorf("Expected 7, got %d err=%v", v, err)
+	}
+
+	// all zero weights fall back to the root
+	v, _ = bh.PollRandomWeighted(func(int) float64 { return 0 })
+	if v != 20 {
+		t.Errorf("Expected root 20 with zero weights, got %d", v)
+	}
+
+	// removing from the middle must keep the heap property intact
+	_, _ = bh.PollRandomWeighted(func(v int) float64 {
+		if v == 3 {
+			return 1
+		}
+		return 0
+	})
+	prev := 1 << 30
+	count := 0
+	for !bh.IsEmpty() {
+		v, _ := bh.Poll()
+		if v > prev || v == 3 || v == 7 {
+			t.Fatalf("unexpected value %d after %d", v, prev)
+		}
+		prev = v
+		count++
+	}
+	if count != 17 {
+		t.Errorf("Expected 17 remaining elements, got %d", count)
 	}
 }
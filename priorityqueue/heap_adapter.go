@@ -0,0 +1,169 @@
+package priorityqueue
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// HeapAdapter exposes an existing container/heap.Interface implementation through
+// the ryushin priority queue API (Add, Poll, Peek, Size, IsEmpty, Clear).
+//
+// It lets codebases that already implement Len/Less/Swap/Push/Pop migrate to
+// ryushin incrementally: call sites can switch to the ryushin method set while the
+// underlying ordering logic stays untouched.
+//
+// Thread-safety:
+//
+//	All adapter methods are protected by a mutex. The wrapped heap.Interface must
+//	not be mutated directly while it is being used through the adapter.
+//
+// Fields:
+//   - h: the wrapped container/heap implementation
+//   - mutex: Mutex to ensure safe concurrent access
+type HeapAdapter[T any] struct {
+	h     heap.Interface
+	mutex sync.Mutex
+}
+
+// NewHeapAdapter wraps h and establishes the heap invariants by calling heap.Init.
+//
+// The elements pushed to and popped from h must be of type T.
+//
+// Example usage:
+//
+//	type IntHeap []int // existing container/heap implementation
+//	...
+//	a := NewHeapAdapter[int](&IntHeap{5, 2, 8})
+//	v, _ := a.Poll() // 2
+//
+// Complexity: O(n)
+func NewHeapAdapter[T any](h heap.Interface) *HeapAdapter[T] {
+	heap.Init(h)
+	return &HeapAdapter[T]{h: h}
+}
+
+// Add pushes val onto the wrapped heap.
+//
+// Complexity: O(log n)
+func (a *HeapAdapter[T]) Add(val T) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	heap.Push(a.h, val)
+}
+
+// Poll removes and returns the element with the highest priority (the minimum
+// according to the wrapped Less).
+//
+// Returns:
+//   - the root element
+//   - ErrEmpty if the heap is empty
+//
+// Complexity: O(log n)
+func (a *HeapAdapter[T]) Poll() (T, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.h.Len() == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return heap.Pop(a.h).(T), nil
+}
+
+// Peek returns the element with the highest priority without removing it.
+//
+// Note: heap.Interface offers no indexed access, so the root is popped and pushed
+// back, which keeps the heap valid but may reorder equal elements.
+//
+// Returns:
+//   - the root element
+//   - ErrEmpty if the heap is empty
+//
+// Complexity: O(log n)
+func (a *HeapAdapter[T]) Peek() (T, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.h.Len() == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	v := heap.Pop(a.h).(T)
+	heap.Push(a.h, v)
+	return v, nil
+}
+
+// Size returns the number of elements in the wrapped heap.
+//
+// Complexity: O(1)
+func (a *HeapAdapter[T]) Size() int {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.h.Len()
+}
+
+// IsEmpty checks whether the wrapped heap contains any elements.
+//
+// Complexity: O(1)
+func (a *HeapAdapter[T]) IsEmpty() bool {
+	return a.Size() == 0
+}
+
+// Clear removes all elements from the wrapped heap by popping them.
+//
+// Complexity: O(n log n)
+func (a *HeapAdapter[T]) Clear() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	for a.h.Len() > 0 {
+		heap.Pop(a.h)
+	}
+}
+
+// heapView adapts a BinaryHeap to container/heap.Interface.
+type heapView[T any] struct {
+	bh *BinaryHeap[T]
+}
+
+// HeapInterface returns a container/heap.Interface view over the heap's internal storage,
+// so existing code built around heap.Push/heap.Pop/heap.Fix can operate on a BinaryHeap.
+//
+// Less(i, j) reports whether element i has higher priority than element j according to
+// the heap's comparator, which matches the min-heap convention of container/heap.
+//
+// Note: the view is not synchronized. The container/heap functions call several methods
+// per operation, so the caller must not use the view concurrently with other goroutines
+// accessing the same BinaryHeap.
+//
+// Example usage:
+//
+//	bh := NewBinaryHeap[int]()
+//	h := bh.HeapInterface()
+//	heap.Push(h, 5)
+//	heap.Push(h, 10)
+//	v, _ := bh.Poll() // 10
+func (bh *BinaryHeap[T]) HeapInterface() heap.Interface {
+	return &heapView[T]{bh: bh}
+}
+
+// Len returns the number of elements in the heap.
+func (v *heapView[T]) Len() int { return len(v.bh.data) }
+
+// Less reports whether element i has higher priority than element j.
+func (v *heapView[T]) Less(i, j int) bool { return v.bh.cmp(v.bh.data[i], v.bh.data[j]) }
+
+// Swap exchanges the elements at indexes i and j.
+func (v *heapView[T]) Swap(i, j int) { v.bh.swap(i, j) }
+
+// Push appends x to the end of the storage; container/heap restores the ordering.
+func (v *heapView[T]) Push(x any) {
+	v.bh.data = append(v.bh.data, x.(T))
+	v.bh.recordPush()
+}
+
+// Pop removes and returns the last element of the storage.
+func (v *heapView[T]) Pop() any {
+	n := len(v.bh.data)
+	last := v.bh.data[n-1]
+	v.bh.data = v.bh.data[:n-1]
+	v.bh.polls++
+	return last
+}
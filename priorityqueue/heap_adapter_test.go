@@ -0,0 +1,80 @@
+package priorityqueue
+
+import (
+	"container/heap"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// intHeap is a classic container/heap min-heap implementation.
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+func TestHeapAdapter(t *testing.T) {
+	a := NewHeapAdapter[int](&intHeap{5, 2, 8})
+	if a.Size() != 3 {
+		t.Fatalf("Expected size 3, got %d", a.Size())
+	}
+	a.Add(1)
+
+	if v, err := a.Peek(); err != nil || v != 1 {
+		t.Errorf("Peek expected 1, got %d err=%v", v, err)
+	}
+	if a.Size() != 4 {
+		t.Errorf("Expected size 4 after Peek, got %d", a.Size())
+	}
+
+	var got []int
+	for !a.IsEmpty() {
+		v, _ := a.Poll()
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 5, 8}) {
+		t.Errorf("Expected [1 2 5 8], got %v", got)
+	}
+	if _, err := a.Poll(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty polling empty adapter, got %v", err)
+	}
+	if _, err := a.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty peeking empty adapter, got %v", err)
+	}
+
+	a.Add(3)
+	a.Clear()
+	if !a.IsEmpty() {
+		t.Errorf("Expected empty adapter after Clear")
+	}
+}
+
+func TestBinaryHeapHeapInterface(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	h := bh.HeapInterface()
+	for _, v := range []int{5, 10, 3, 7} {
+		heap.Push(h, v)
+	}
+	if bh.Size() != 4 {
+		t.Fatalf("Expected size 4, got %d", bh.Size())
+	}
+	if v := heap.Pop(h).(int); v != 10 {
+		t.Errorf("Expected heap.Pop to return 10, got %d", v)
+	}
+	bh.Add(8)
+	expected := []int{8, 7, 5, 3}
+	for _, exp := range expected {
+		if v, _ := bh.Poll(); v != exp {
+			t.Errorf("Expected %d, got %d", exp, v)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package priorityqueue
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ShardedHeap is a lock-striped priority queue intended for high-contention workloads.
+//
+// Instead of funnelling every goroutine through a single mutex, the elements are spread
+// across several independent BinaryHeap shards. Add picks a shard in round-robin order,
+// so concurrent producers rarely contend on the same lock. Poll inspects the root of
+// every shard and removes the best one ("best-of-shards").
+//
+// Ordering guarantees:
+//   - When the heap is not being modified concurrently, Poll always returns the element
+//     with the highest priority, exactly like BinaryHeap.
+//   - Under concurrent modification the ordering is relaxed: Poll returns the best
+//     root it observed, which may be overtaken by an element added in the meantime.
+//     This trade-off is typical for schedulers that favor throughput over strict order.
+//
+// Fields:
+//   - shards: independent heaps, each protected by its own mutex
+//   - cmp: comparator shared by all shards
+//   - next: round-robin counter used to distribute Add calls
+type ShardedHeap[T any] struct {
+	shards []*BinaryHeap[T]
+	cmp    func(a, b T) bool
+	next   atomic.Uint64
+}
+
+// NewShardedHeap creates a new max-heap ShardedHeap using the natural ordering of T.
+//
+// If shards <= 0, the number of shards defaults to runtime.GOMAXPROCS(0).
+//
+// Example usage:
+//
+//	sh := NewShardedHeap[int](8)
+//	sh.Add(5)
+//	sh.Add(10)
+//	v, _ := sh.Poll() // 10
+func NewShardedHeap[T constraints.Ordered](shards int) *ShardedHeap[T] {
+	return NewShardedHeapWithComparator[T](shards, func(a, b T) bool {
+		return a > b
+	})
+}
+
+// NewShardedHeapWithComparator creates a new ShardedHeap using a custom comparator.
+//
+// The comparator follows the same contract as NewBinaryHeapWithComparator:
+// it should return true if a has higher priority than b.
+//
+// If shards <= 0, the number of shards defaults to runtime.GOMAXPROCS(0).
+func NewShardedHeapWithComparator[T any](shards int, cmp func(a, b T) bool) *ShardedHeap[T] {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	sh := &ShardedHeap[T]{
+		shards: make([]*BinaryHeap[T], shards),
+		cmp:    cmp,
+	}
+	for i := range sh.shards {
+		sh.shards[i] = NewBinaryHeapWithComparator[T](cmp)
+	}
+	return sh
+}
+
+// Add inserts a new element into one of the shards.
+//
+// Complexity: O(log(n/s)), where s is the number of shards
+func (sh *ShardedHeap[T]) Add(val T) {
+	idx := (sh.next.Add(1) - 1) % uint64(len(sh.shards))
+	sh.shards[idx].Add(val)
+}
+
+// best scans the roots of all shards and returns the index of the shard
+// holding the highest-priority root, or -1 if every shard is empty.
+//
+// Complexity: O(s)
+func (sh *ShardedHeap[T]) best() (int, T) {
+	var bestVal T
+	bestIdx := -1
+	for i, shard := range sh.shards {
+		shard.mutex.RLock()
+		if len(shard.data) > 0 {
+			root := shard.data[0]
+			if bestIdx == -1 || sh.cmp(root, bestVal) {
+				bestIdx = i
+				bestVal = root
+			}
+		}
+		shard.mutex.RUnlock()
+	}
+	return bestIdx, bestVal
+}
+
+// Peek returns the highest-priority root across all shards without removing it.
+//
+// Returns:
+//   - the root element
+//   - ErrEmpty if the heap is empty
+//
+// Complexity: O(s)
+func (sh *ShardedHeap[T]) Peek() (T, error) {
+	idx, val := sh.best()
+	if idx == -1 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return val, nil
+}
+
+// Poll removes and returns the highest-priority root across all shards.
+//
+// Algorithm Steps:
+//  1. Scan the roots of all shards under their read locks and pick the best.
+//  2. Lock the chosen shard and remove its root.
+//  3. If the shard was drained by another goroutine in the meantime, retry.
+//
+// Returns:
+//   - the root element
+//   - ErrEmpty if the heap is empty
+//
+// Complexity: O(s + log(n/s))
+func (sh *ShardedHeap[T]) Poll() (T, error) {
+	for {
+		idx, _ := sh.best()
+		if idx == -1 {
+			var zero T
+			return zero, ErrEmpty
+		}
+		shard := sh.shards[idx]
+		shard.mutex.Lock()
+		if len(shard.data) > 0 {
+			v, err := shard.removeAt(0)
+			shard.mutex.Unlock()
+			return v, err
+		}
+		shard.mutex.Unlock()
+	}
+}
+
+// Size returns the total number of elements across all shards.
+//
+// The result is a best-effort snapshot when the heap is modified concurrently.
+//
+// Complexity: O(s)
+func (sh *ShardedHeap[T]) Size() int {
+	total := 0
+	for _, shard := range sh.shards {
+		total += shard.Size()
+	}
+	return total
+}
+
+// IsEmpty checks whether every shard is empty.
+//
+// Complexity: O(s)
+func (sh *ShardedHeap[T]) IsEmpty() bool {
+	return sh.Size() == 0
+}
+
+// Clear removes all elements from every shard.
+//
+// Complexity: O(s)
+func (sh *ShardedHeap[T]) Clear() {
+	for _, shard := range sh.shards {
+		shard.Clear()
+	}
+}
+
+// Shards returns the number of shards used by the heap.
+//
+// Complexity: O(1)
+func (sh *ShardedHeap[T]) Shards() int {
+	return len(sh.shards)
+}
@@ -0,0 +1,33 @@
+package priorityqueue
+
+import "testing"
+
+func BenchmarkShardedHeapAddPollParallel(b *testing.B) {
+	sh := NewShardedHeap[int](0)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			sh.Add(i)
+			_, _ = sh.Poll()
+			i++
+		}
+	})
+}
+
+func BenchmarkBinaryHeapAddPollParallel(b *testing.B) {
+	bh := NewBinaryHeap[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			bh.Add(i)
+			_, _ = bh.Poll()
+			i++
+		}
+	})
+}
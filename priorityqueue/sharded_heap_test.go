@@ -0,0 +1,110 @@
+package priorityqueue
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestShardedHeapOrdering(t *testing.T) {
+	sh := NewShardedHeap[int](4)
+	if !sh.IsEmpty() {
+		t.Fatalf("Expected empty sharded heap")
+	}
+	if _, err := sh.Poll(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty when polling empty sharded heap, got %v", err)
+	}
+	if _, err := sh.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty when peeking empty sharded heap, got %v", err)
+	}
+
+	values := []int{10, 5, 30, 20, 40, 35, 15, 25}
+	for _, v := range values {
+		sh.Add(v)
+	}
+	if sh.Size() != len(values) {
+		t.Fatalf("Expected size %d, got %d", len(values), sh.Size())
+	}
+
+	top, err := sh.Peek()
+	if err != nil || top != 40 {
+		t.Errorf("Peek expected 40, got %v err=%v", top, err)
+	}
+
+	expected := []int{40, 35, 30, 25, 20, 15, 10, 5}
+	for _, exp := range expected {
+		v, err := sh.Poll()
+		if err != nil || v != exp {
+			t.Errorf("Poll expected %d, got %d err=%v", exp, v, err)
+		}
+	}
+
+	sh.Add(1)
+	sh.Clear()
+	if !sh.IsEmpty() {
+		t.Errorf("Expected empty sharded heap after Clear")
+	}
+}
+
+func TestShardedHeapDefaultShards(t *testing.T) {
+	sh := NewShardedHeapWithComparator[int](0, func(a, b int) bool { return a < b })
+	if sh.Shards() <= 0 {
+		t.Fatalf("Expected positive shard count, got %d", sh.Shards())
+	}
+	sh.Add(3)
+	sh.Add(1)
+	sh.Add(2)
+	if v, _ := sh.Poll(); v != 1 {
+		t.Errorf("Expected min-heap root 1, got %d", v)
+	}
+}
+
+func TestShardedHeapConcurrency(t *testing.T) {
+	sh := NewShardedHeap[int](8)
+	const goroutines, perG = 50, 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perG; i++ {
+				sh.Add(g*perG + i)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if sh.Size() != goroutines*perG {
+		t.Fatalf("Expected size %d, got %d", goroutines*perG, sh.Size())
+	}
+
+	seen := make([]bool, goroutines*perG)
+	var mu sync.Mutex
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perG; i++ {
+				v, err := sh.Poll()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				mu.Lock()
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, ok := range seen {
+		if !ok {
+			t.Fatalf("value %d was never polled", i)
+		}
+	}
+	if !sh.IsEmpty() {
+		t.Errorf("Expected empty sharded heap after concurrent polls")
+	}
+}
@@ -0,0 +1,159 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Zubayear/ryushin/priorityqueue"
+)
+
+// delayed is an element of a DelayQueue together with the time it becomes available.
+// seq records the enqueue order, so elements available at the same time leave the
+// queue in FIFO order.
+type delayed[T comparable] struct {
+	val T
+	at  time.Time
+	seq uint64
+}
+
+// DelayQueue is a concurrency-safe queue whose elements only become available once
+// their delay has passed, e.g. for retry scheduling or visibility timeouts.
+//
+// Elements are kept in a min-heap ordered by availability time, so Dequeue returns
+// the element that became available first; elements available at the same time are
+// returned in FIFO order.
+//
+// Fields:
+//   - items: the pending elements, earliest availability time first
+//   - seq: the number of elements enqueued so far, used to keep FIFO order on ties
+//   - changed: closed and replaced whenever an element is enqueued, to wake up Take
+//   - mutex: guards the queue
+//
+// Example:
+//
+//	dq := queue.NewDelayQueue[Job]()
+//	dq.Enqueue(job, 30*time.Second) // retry in 30 seconds
+//	next, err := dq.Take(ctx)       // blocks until a job is due
+type DelayQueue[T comparable] struct {
+	items   *priorityqueue.BinaryHeap[delayed[T]]
+	seq     uint64
+	changed chan struct{}
+	mutex   sync.Mutex
+}
+
+// NewDelayQueue creates and returns an empty DelayQueue.
+//
+// Complexity: O(1)
+func NewDelayQueue[T comparable]() *DelayQueue[T] {
+	return &DelayQueue[T]{
+		items: priorityqueue.NewBinaryHeapWithComparator(func(a, b delayed[T]) bool {
+			if !a.at.Equal(b.at) {
+				return a.at.Before(b.at)
+			}
+			return a.seq < b.seq
+		}),
+		changed: make(chan struct{}),
+	}
+}
+
+// Enqueue adds an element that becomes available after delay. A delay <= 0 makes it
+// available immediately.
+//
+// Complexity: O(log n)
+func (dq *DelayQueue[T]) Enqueue(val T, delay time.Duration) {
+	dq.EnqueueAt(val, time.Now().Add(delay))
+}
+
+// EnqueueAt adds an element that becomes available at the given time.
+//
+// Complexity: O(log n)
+func (dq *DelayQueue[T]) EnqueueAt(val T, at time.Time) {
+	dq.mutex.Lock()
+	defer dq.mutex.Unlock()
+	dq.items.Add(delayed[T]{val: val, at: at, seq: dq.seq})
+	dq.seq++
+	// wake up waiting Takes, as the new element may be available earlier
+	close(dq.changed)
+	dq.changed = make(chan struct{})
+}
+
+// Dequeue removes and returns the element that became available first, without
+// waiting. Returns ErrEmpty if the queue is empty, or ErrNotReady if no element is available yet.
+//
+// Complexity: O(log n)
+func (dq *DelayQueue[T]) Dequeue() (T, error) {
+	dq.mutex.Lock()
+	defer dq.mutex.Unlock()
+	var zero T
+	if dq.items.IsEmpty() {
+		return zero, ErrEmpty
+	}
+	now := time.Now()
+	d, ok := dq.items.PollIf(func(d delayed[T]) bool { return !d.at.After(now) })
+	if !ok {
+		return zero, ErrNotReady
+	}
+	return d.val, nil
+}
+
+// Take removes and returns the element that becomes available first, waiting until
+// one is available or ctx is done. In the latter case it returns ctx.Err().
+//
+// Algorithm Steps:
+//  1. If the earliest element is available, remove and return it.
+//  2. Otherwise wait until it becomes available, an element is enqueued (it may be
+//     available earlier) or ctx is done, then start over.
+//
+// Complexity: O(log n), excluding the time spent waiting
+func (dq *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		dq.mutex.Lock()
+		next, err := dq.items.Peek()
+		changed := dq.changed
+		if err == nil && !next.at.After(time.Now()) {
+			_, _ = dq.items.Poll()
+			dq.mutex.Unlock()
+			return next.val, nil
+		}
+		dq.mutex.Unlock()
+
+		// with an empty queue there is no timer, so only an Enqueue or ctx wakes us up
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if err == nil {
+			timer = time.NewTimer(time.Until(next.at))
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-changed:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+	}
+}
+
+// Size returns the number of elements in the queue, available or not.
+//
+// Complexity: O(1)
+func (dq *DelayQueue[T]) Size() int {
+	dq.mutex.Lock()
+	defer dq.mutex.Unlock()
+	return dq.items.Size()
+}
+
+// IsEmpty checks if the queue contains no elements, available or not.
+//
+// Complexity: O(1)
+func (dq *DelayQueue[T]) IsEmpty() bool {
+	dq.mutex.Lock()
+	defer dq.mutex.Unlock()
+	return dq.items.IsEmpty()
+}
@@ -0,0 +1,89 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDelayQueueDequeue(t *testing.T) {
+	dq := NewDelayQueue[string]()
+	if _, err := dq.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty on Dequeue for empty queue, got %v", err)
+	}
+
+	dq.Enqueue("later", time.Hour)
+	dq.Enqueue("first", 0)
+	dq.Enqueue("second", -time.Second)
+	dq.EnqueueAt("third", time.Now().Add(-time.Second))
+	if dq.Size() != 4 || dq.IsEmpty() {
+		t.Errorf("Expected %v, got %v", 4, dq.Size())
+	}
+
+	// earliest availability first; equal times keep FIFO order
+	for _, want := range []string{"second", "third", "first"} {
+		if v, err := dq.Dequeue(); err != nil || v != want {
+			t.Errorf("Dequeue() = %v, %v; want %v", v, err, want)
+		}
+	}
+	if _, err := dq.Dequeue(); !errors.Is(err, ErrNotReady) {
+		t.Errorf("Expected ErrNotReady while no element is available, got %v", err)
+	}
+	if dq.Size() != 1 {
+		t.Errorf("Expected the delayed element to stay queued")
+	}
+}
+
+func TestDelayQueueTake(t *testing.T) {
+	dq := NewDelayQueue[int]()
+	start := time.Now()
+	dq.Enqueue(1, 30*time.Millisecond)
+	v, err := dq.Take(context.Background())
+	if err != nil || v != 1 {
+		t.Fatalf("Take() = %v, %v; want 1", v, err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Take returned after %v, before the element was available", elapsed)
+	}
+}
+
+func TestDelayQueueTakeWakesOnEarlierElement(t *testing.T) {
+	dq := NewDelayQueue[string]()
+	dq.Enqueue("slow", time.Hour)
+	got := make(chan string)
+	go func() {
+		v, _ := dq.Take(context.Background())
+		got <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	dq.Enqueue("fast", 10*time.Millisecond)
+	select {
+	case v := <-got:
+		if v != "fast" {
+			t.Errorf("Take() = %v; want fast", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Take did not wake up for the earlier element")
+	}
+}
+
+func TestDelayQueueTakeCancelled(t *testing.T) {
+	dq := NewDelayQueue[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := dq.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded on empty queue, got %v", err)
+	}
+
+	dq.Enqueue(1, time.Hour)
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := dq.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded while waiting for the element, got %v", err)
+	}
+	if dq.Size() != 1 {
+		t.Errorf("Expected the element to stay queued")
+	}
+}
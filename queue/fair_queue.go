@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"slices"
+	"sync"
+)
+
+// FairQueue is a concurrency-safe queue that multiplexes per-key sub-queues (e.g. one
+// per tenant) and serves them in weighted round-robin order, so one busy producer
+// cannot starve the others.
+//
+// Each key with pending elements gets up to weight consecutive dequeues per round
+// (1 unless set with SetWeight) before the next key is served. Within a key, elements
+// are returned in FIFO order.
+//
+// Fields:
+//   - lanes: the sub-queue of every key with pending elements
+//   - weights: the weights set with SetWeight
+//   - active: the keys with pending elements, in round-robin order
+//   - cursor: the index in active of the key currently served
+//   - served: the number of elements dequeued from that key in the current round
+//   - size: the total number of elements
+//   - mutex: guards the queue
+//
+// Example:
+//
+//	fq := queue.NewFairQueue[string, Job]()
+//	fq.SetWeight("premium", 3)
+//	fq.Enqueue("free", job1)
+//	fq.Enqueue("premium", job2)
+//	job, _ := fq.Dequeue()
+type FairQueue[K comparable, T comparable] struct {
+	lanes   map[K]*Queue[T]
+	weights map[K]int
+	active  []K
+	cursor  int
+	served  int
+	size    int
+	mutex   sync.Mutex
+}
+
+// NewFairQueue creates and returns an empty FairQueue.
+//
+// Complexity: O(1)
+func NewFairQueue[K comparable, T comparable]() *FairQueue[K, T] {
+	return &FairQueue[K, T]{
+		lanes:   make(map[K]*Queue[T]),
+		weights: make(map[K]int),
+	}
+}
+
+// SetWeight sets how many consecutive elements are dequeued for key per round.
+// A weight below 1 is treated as 1.
+//
+// Complexity: O(1)
+func (fq *FairQueue[K, T]) SetWeight(key K, weight int) {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+	if weight <= 1 {
+		delete(fq.weights, key)
+		return
+	}
+	fq.weights[key] = weight
+}
+
+// weight returns the weight of key. The caller must hold the lock.
+func (fq *FairQueue[K, T]) weight(key K) int {
+	if w, ok := fq.weights[key]; ok {
+		return w
+	}
+	return 1
+}
+
+// Enqueue adds an element to the rear of the sub-queue of key.
+//
+// Complexity: O(1) amortized
+func (fq *FairQueue[K, T]) Enqueue(key K, val T) {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+	lane, ok := fq.lanes[key]
+	if !ok {
+		lane = NewQueue[T]()
+		fq.lanes[key] = lane
+		// new keys join at the end of the current round
+		fq.active = append(fq.active, key)
+	}
+	lane.Enqueue(val)
+	fq.size++
+}
+
+// Dequeue removes and returns the next element in weighted round-robin order,
+// together with its key. Returns ErrEmpty if the queue is empty.
+//
+// Algorithm Steps:
+//  1. Dequeue from the sub-queue of the key at the cursor.
+//  2. If the sub-queue is now empty, drop the key from the round.
+//  3. Otherwise, once the key has been served weight times, move the cursor on.
+//
+// Complexity: O(1), or O(k) when a key is dropped, where k = number of active keys.
+func (fq *FairQueue[K, T]) Dequeue() (K, T, error) {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+	var (
+		zeroK K
+		zeroT T
+	)
+	if len(fq.active) == 0 {
+		return zeroK, zeroT, ErrEmpty
+	}
+	key := fq.active[fq.cursor]
+	lane := fq.lanes[key]
+	val, _ := lane.Dequeue()
+	fq.size--
+	fq.served++
+
+	switch {
+	case lane.IsEmpty():
+		delete(fq.lanes, key)
+		fq.active = slices.Delete(fq.active, fq.cursor, fq.cursor+1)
+		fq.served = 0
+	case fq.served >= fq.weight(key):
+		fq.cursor++
+		fq.served = 0
+	default:
+		return key, val, nil
+	}
+	if fq.cursor >= len(fq.active) {
+		fq.cursor = 0
+	}
+	return key, val, nil
+}
+
+// Size returns the total number of elements in all sub-queues.
+//
+// Complexity: O(1)
+func (fq *FairQueue[K, T]) Size() int {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+	return fq.size
+}
+
+// Len returns the number of elements in the sub-queue of key.
+//
+// Complexity: O(1)
+func (fq *FairQueue[K, T]) Len(key K) int {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+	if lane, ok := fq.lanes[key]; ok {
+		return lane.Size()
+	}
+	return 0
+}
+
+// IsEmpty checks if all sub-queues are empty.
+//
+// Complexity: O(1)
+func (fq *FairQueue[K, T]) IsEmpty() bool {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+	return fq.size == 0
+}
@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFairQueueRoundRobin(t *testing.T) {
+	fq := NewFairQueue[string, int]()
+	if _, _, err := fq.Dequeue(); err == nil {
+		t.Errorf("Expected error on Dequeue for empty queue")
+	}
+
+	// a busy producer does not starve the others
+	for i := 0; i < 5; i++ {
+		fq.Enqueue("busy", i)
+	}
+	fq.Enqueue("quiet", 100)
+	fq.Enqueue("other", 200)
+	fq.Enqueue("quiet", 101)
+	if fq.Size() != 8 || fq.Len("busy") != 5 || fq.Len("none") != 0 {
+		t.Errorf("unexpected sizes: %v, %v", fq.Size(), fq.Len("busy"))
+	}
+
+	var keys []string
+	var vals []int
+	for !fq.IsEmpty() {
+		k, v, err := fq.Dequeue()
+		if err != nil {
+			t.Fatalf("Dequeue error: %v", err)
+		}
+		keys = append(keys, k)
+		vals = append(vals, v)
+	}
+	wantKeys := []string{"busy", "quiet", "other", "busy", "quiet", "busy", "busy", "busy"}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("keys = %v; want %v", keys, wantKeys)
+	}
+	if want := []int{0, 100, 200, 1, 101, 2, 3, 4}; !reflect.DeepEqual(vals, want) {
+		t.Errorf("values = %v; want %v", vals, want)
+	}
+}
+
+func TestFairQueueWeights(t *testing.T) {
+	fq := NewFairQueue[string, int]()
+	fq.SetWeight("a", 3)
+	fq.SetWeight("b", 0)
+	for i := 0; i < 6; i++ {
+		fq.Enqueue("a", i)
+		fq.Enqueue("b", i)
+	}
+
+	var keys []string
+	for i := 0; i < 8; i++ {
+		k, _, _ := fq.Dequeue()
+		keys = append(keys, k)
+	}
+	want := []string{"a", "a", "a", "b", "a", "a", "a", "b"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %v; want %v", keys, want)
+	}
+
+	// a was drained and dropped; a new key joins at the end of the round
+	fq.Enqueue("c", 0)
+	keys = nil
+	for !fq.IsEmpty() {
+		k, _, _ := fq.Dequeue()
+		keys = append(keys, k)
+	}
+	if want := []string{"b", "c", "b", "b", "b"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %v; want %v", keys, want)
+	}
+}
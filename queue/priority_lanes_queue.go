@@ -0,0 +1,128 @@
+package queue
+
+import "sync"
+
+// PriorityLanesQueue is a concurrency-safe queue with a fixed number of strict-priority
+// FIFO lanes. Dequeue always serves the highest-priority non-empty lane, lane 0 being
+// the highest, so urgent elements jump ahead while elements of the same lane keep
+// their FIFO order.
+//
+// Compared to a BinaryHeap with a comparator, enqueueing and dequeueing are O(1) for
+// a fixed number of lanes, and no tie-breaking is needed to preserve FIFO order.
+//
+// Note: lower-priority lanes are only served while all higher ones are empty, so a
+// steady stream of urgent elements starves them. Use FairQueue when that matters.
+//
+// Fields:
+//   - lanes: one FIFO queue per priority level, highest priority first
+//   - size: the total number of elements
+//   - mutex: guards the queue
+//
+// Example:
+//
+//	pq := queue.NewPriorityLanesQueue[Job](3)
+//	_ = pq.Enqueue(batchJob, 2)
+//	_ = pq.Enqueue(pageAlert, 0)
+//	job, _ := pq.Dequeue() // pageAlert
+type PriorityLanesQueue[T comparable] struct {
+	lanes []*Queue[T]
+	size  int
+	mutex sync.Mutex
+}
+
+// NewPriorityLanesQueue creates and returns an empty PriorityLanesQueue with the given
+// number of lanes. A number below 1 is treated as 1.
+//
+// Complexity: O(l), where l = number of lanes
+func NewPriorityLanesQueue[T comparable](lanes int) *PriorityLanesQueue[T] {
+	pq := &PriorityLanesQueue[T]{lanes: make([]*Queue[T], max(lanes, 1))}
+	for i := range pq.lanes {
+		pq.lanes[i] = NewQueue[T]()
+	}
+	return pq
+}
+
+// Enqueue adds an element to the rear of the given lane.
+// Returns ErrInvalidLane if lane is out of range.
+//
+// Complexity: O(1) amortized
+func (pq *PriorityLanesQueue[T]) Enqueue(val T, lane int) error {
+	if lane < 0 || lane >= len(pq.lanes) {
+		return ErrInvalidLane
+	}
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	pq.lanes[lane].Enqueue(val)
+	pq.size++
+	return nil
+}
+
+// Dequeue removes and returns the front element of the highest-priority non-empty
+// lane. Returns ErrEmpty if the queue is empty.
+//
+// Complexity: O(l) worst case, where l = number of lanes
+func (pq *PriorityLanesQueue[T]) Dequeue() (T, error) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	for _, lane := range pq.lanes {
+		if val, err := lane.Dequeue(); err == nil {
+			pq.size--
+			return val, nil
+		}
+	}
+	var zero T
+	return zero, ErrEmpty
+}
+
+// Peek returns the element Dequeue would return, without removing it.
+// Returns ErrEmpty if the queue is empty.
+//
+// Complexity: O(l) worst case, where l = number of lanes
+func (pq *PriorityLanesQueue[T]) Peek() (T, error) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	for _, lane := range pq.lanes {
+		if val, err := lane.Peek(); err == nil {
+			return val, nil
+		}
+	}
+	var zero T
+	return zero, ErrEmpty
+}
+
+// Size returns the total number of elements in all lanes.
+//
+// Complexity: O(1)
+func (pq *PriorityLanesQueue[T]) Size() int {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	return pq.size
+}
+
+// LaneSize returns the number of elements in the given lane, or 0 if lane is out of range.
+//
+// Complexity: O(1)
+func (pq *PriorityLanesQueue[T]) LaneSize(lane int) int {
+	if lane < 0 || lane >= len(pq.lanes) {
+		return 0
+	}
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	return pq.lanes[lane].Size()
+}
+
+// IsEmpty checks if all lanes are empty.
+//
+// Complexity: O(1)
+func (pq *PriorityLanesQueue[T]) IsEmpty() bool {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	return pq.size == 0
+}
+
+// Lanes returns the number of lanes.
+//
+// Complexity: O(1)
+func (pq *PriorityLanesQueue[T]) Lanes() int {
+	return len(pq.lanes)
+}
@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPriorityLanesQueue(t *testing.T) {
+	pq := NewPriorityLanesQueue[string](3)
+	if _, err := pq.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty on Dequeue for empty queue, got %v", err)
+	}
+	if _, err := pq.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty on Peek for empty queue, got %v", err)
+	}
+
+	for _, e := range []struct {
+		val  string
+		lane int
+	}{{"low1", 2}, {"mid1", 1}, {"low2", 2}, {"high1", 0}, {"mid2", 1}, {"high2", 0}} {
+		if err := pq.Enqueue(e.val, e.lane); err != nil {
+			t.Fatalf("Enqueue(%v, %v) error: %v", e.val, e.lane, err)
+		}
+	}
+	for _, lane := range []int{-1, 3} {
+		if err := pq.Enqueue("x", lane); !errors.Is(err, ErrInvalidLane) {
+			t.Errorf("Expected ErrInvalidLane for lane %v, got %v", lane, err)
+		}
+	}
+	if pq.Size() != 6 || pq.LaneSize(2) != 2 || pq.LaneSize(5) != 0 || pq.Lanes() != 3 {
+		t.Errorf("unexpected sizes: %v, %v", pq.Size(), pq.LaneSize(2))
+	}
+	if v, _ := pq.Peek(); v != "high1" {
+		t.Errorf("Peek() = %v; want high1", v)
+	}
+
+	var got []string
+	for !pq.IsEmpty() {
+		v, err := pq.Dequeue()
+		if err != nil {
+			t.Fatalf("Dequeue error: %v", err)
+		}
+		got = append(got, v)
+		if v == "mid1" {
+			// an urgent element jumps ahead of the remaining ones
+			_ = pq.Enqueue("high3", 0)
+		}
+	}
+	want := []string{"high1", "high2", "mid1", "high3", "mid2", "low1", "low2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dequeued %v; want %v", got, want)
+	}
+	if NewPriorityLanesQueue[int](0).Lanes() != 1 {
+		t.Errorf("Expected lanes below 1 to be treated as 1")
+	}
+}
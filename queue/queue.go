@@ -6,8 +6,20 @@ efficient memory usage and supports dynamic resizing when the queue is full.
 Features:
   - Generic Type Support: Works with any comparable type.
   - Thread-Safety: All operations are protected using sync.RWMutex.
-  - Dynamic Resizing: Doubles capacity automatically when full.
+  - Dynamic Resizing: Doubles capacity automatically when full, and halves it when
+    it falls to a quarter full, never below the initial capacity.
   - Utility Methods: Peek, IsEmpty, IsFull, Size, Clear, Print.
+  - Inspection: PeekAt and PeekLast look past the front without dequeuing.
+  - Clone / Equal: Copy a queue or compare the FIFO contents of two queues.
+  - Memory Release: Shrink and Clear return unused backing storage to the runtime.
+  - Iteration: Iterator and All (range-over-func) over a FIFO snapshot.
+  - Batch Operations: EnqueueAll, DequeueN and DrainIf under a single lock acquisition.
+  - Expiration: EnqueueWithTTL adds elements that are skipped once their TTL runs out.
+  - FairQueue: Weighted round-robin over per-key sub-queues.
+  - SynchronousQueue: A rendezvous queue without capacity for direct hand-off.
+  - PriorityLanesQueue: Strict-priority FIFO lanes without a comparator.
+  - DelayQueue: A queue whose elements become available after a per-element delay,
+    with a blocking Take.
 
 Use Cases:
   - Task scheduling and job queues.
@@ -25,13 +37,15 @@ Example:
 
 Implementation Details:
   - Uses a circular array to store elements.
-  - `front` and `rear` track positions for dequeue and enqueue operations.
+  - `front` and `rear` track positions for dequeue and enqueue operations; both
+    wrap around modulo the capacity, so they stay within the backing slice.
   - Automatically resizes when the number of elements equals capacity.
   - Protected by RWMutex for concurrent access.
 
 Complexity:
   - Enqueue: O(1) amortized
   - Dequeue: O(1)
+  - EnqueueAll / DequeueN: O(k) for k elements
   - Peek: O(1)
   - Size: O(1)
   - Print: O(n)
@@ -41,8 +55,23 @@ package queue
 import (
 	"errors"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 	"sync"
+	"time"
+)
+
+// Errors returned by the queues of this package. Callers can test for them with errors.Is.
+var (
+	// ErrEmpty is returned when an element is requested from an empty queue.
+	ErrEmpty = errors.New("queue empty")
+	// ErrInvalidIndex is returned when an index is outside the queue.
+	ErrInvalidIndex = errors.New("invalid index")
+	// ErrInvalidLane is returned by PriorityLanesQueue when a lane is out of range.
+	ErrInvalidLane = errors.New("invalid lane")
+	// ErrNotReady is returned by DelayQueue.Dequeue when no element's delay has elapsed yet.
+	ErrNotReady = errors.New("no element available")
 )
 
 // Queue represents a generic circular queue with dynamic resizing.
@@ -63,15 +92,33 @@ import (
 //	fmt.Println(val) // Output: 10
 type Queue[T comparable] struct {
 	front, rear, cap, count int
+	minCap                  int
 	data                    []T
+	expiry                  []time.Time // parallel to data; nil until EnqueueWithTTL is used
+	now                     func() time.Time
 	mutex                   sync.RWMutex
 }
 
+// defaultCapacity is the initial capacity of a queue created by NewQueue.
+const defaultCapacity = 16
+
 // NewQueue creates and returns a new queue with an initial capacity of 16.
 //
 // Complexity: O(1)
 func NewQueue[T comparable]() *Queue[T] {
-	return &Queue[T]{cap: 16, front: 0, rear: 0, count: 0, data: make([]T, 16)}
+	return NewQueueWithCapacity[T](defaultCapacity)
+}
+
+// NewQueueWithCapacity creates and returns a new queue with an initial capacity of n,
+// avoiding repeated resizing when the expected number of elements is known.
+// The queue never shrinks below this capacity. If n < 1, the capacity is 16.
+//
+// Complexity: O(n)
+func NewQueueWithCapacity[T comparable](n int) *Queue[T] {
+	if n < 1 {
+		n = defaultCapacity
+	}
+	return &Queue[T]{cap: n, minCap: n, data: make([]T, n), now: time.Now}
 }
 
 // increaseSize doubles the capacity of the queue when it's full
@@ -85,17 +132,31 @@ func NewQueue[T comparable]() *Queue[T] {
 //
 // Complexity: O(n), where n = current number of elements.
 func (q *Queue[T]) increaseSize() {
-	newCap := q.cap * 2
+	q.resize(q.cap * 2)
+}
+
+// resize moves the elements into a new slice of capacity newCap, which must hold
+// at least count elements, so that the front of the queue is at index 0.
+//
+// Complexity: O(n), where n = current number of elements.
+func (q *Queue[T]) resize(newCap int) {
 	newData := make([]T, newCap)
 
 	// Copy elements in the correct order
 	for i := 0; i < q.count; i++ {
 		newData[i] = q.data[(q.front+i)%q.cap]
 	}
+	if q.expiry != nil {
+		newExpiry := make([]time.Time, newCap)
+		for i := 0; i < q.count; i++ {
+			newExpiry[i] = q.expiry[(q.front+i)%q.cap]
+		}
+		q.expiry = newExpiry
+	}
 
 	q.data = newData
 	q.front = 0
-	q.rear = q.count
+	q.rear = q.count % newCap
 	q.cap = newCap
 }
 
@@ -104,8 +165,8 @@ func (q *Queue[T]) increaseSize() {
 //
 // Algorithm Steps:
 //  1. If full, increase capacity using increaseSize().
-//  2. Insert element at rear index (mod cap).
-//  3. Increment rear and count.
+//  2. Insert element at rear index.
+//  3. Advance rear (mod cap) and increment count.
 //
 // Complexity: O(1) amortized, O(n) when resizing.
 func (q *Queue[T]) Enqueue(val T) {
@@ -114,47 +175,233 @@ func (q *Queue[T]) Enqueue(val T) {
 	if q.count == q.cap {
 		q.increaseSize()
 	}
-	q.data[q.rear%q.cap] = val
-	q.rear++
+	q.data[q.rear] = val
+	q.rear = (q.rear + 1) % q.cap
 	q.count++
 }
 
+// shrinkIfSparse halves the capacity once the queue is at most a quarter full, as long
+// as it stays at or above the initial capacity. Halving at a quarter, rather than at
+// half, leaves room to grow again before the next resize, keeping both O(1) amortized.
+//
+// Complexity: O(n) when resizing, O(1) otherwise.
+func (q *Queue[T]) shrinkIfSparse() {
+	for q.count <= q.cap/4 && q.cap/2 >= q.minCap {
+		q.resize(q.cap / 2)
+	}
+}
+
+// Shrink reduces the capacity of the queue to fit its current elements, but never
+// below the initial capacity, releasing the rest of the backing slice.
+//
+// Complexity: O(n)
+func (q *Queue[T]) Shrink() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if newCap := max(q.count, q.minCap); newCap < q.cap {
+		q.resize(newCap)
+	}
+}
+
+// EnqueueAll adds vals to the rear of the queue in order, under a single lock
+// acquisition. The capacity is checked once and grown, by repeated doubling,
+// to fit the whole batch.
+//
+// Algorithm Steps:
+//  1. Double the capacity until count + len(vals) elements fit, then resize once.
+//  2. Insert the elements at consecutive rear indices, wrapping around (mod cap).
+//  3. Advance count by len(vals).
+//
+// Complexity: O(k) amortized, where k = len(vals); O(n + k) when resizing.
+func (q *Queue[T]) EnqueueAll(vals ...T) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if need := q.count + len(vals); need > q.cap {
+		newCap := q.cap
+		for newCap < need {
+			newCap *= 2
+		}
+		q.resize(newCap)
+	}
+	for _, val := range vals {
+		q.data[q.rear] = val
+		q.rear = (q.rear + 1) % q.cap
+	}
+	q.count += len(vals)
+}
+
+// DequeueN removes and returns up to n elements from the front of the queue, in FIFO
+// order, under a single lock acquisition. Expired elements are dropped on the way and
+// do not count towards n. It returns an empty slice if the queue is empty or n <= 0.
+//
+// Complexity: O(k), where k = number of elements removed.
+func (q *Queue[T]) DequeueN(n int) []T {
+	var zero T
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	now := q.now()
+	result := make([]T, 0, max(min(n, q.count), 0))
+	for len(result) < n && q.count > 0 {
+		if !q.expired(q.front, now) {
+			result = append(result, q.data[q.front])
+		}
+		q.data[q.front] = zero
+		q.popFront()
+	}
+	q.shrinkIfSparse()
+	return result
+}
+
+// DrainIf removes and returns, in FIFO order, every element for which pred returns
+// true, in a single pass under one lock acquisition. The other elements keep their
+// order. Expired elements are not passed to pred.
+//
+// The lock is held while pred runs, so pred must not use the queue.
+//
+// Example:
+//
+//	dropped := q.DrainIf(func(m Message) bool { return m.ClientID == disconnected })
+//
+// Complexity: O(n)
+func (q *Queue[T]) DrainIf(pred func(T) bool) []T {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	var drained []T
+	now := q.now()
+	q.compact(func(idx int) bool {
+		if q.expired(idx, now) || !pred(q.data[idx]) {
+			return true
+		}
+		drained = append(drained, q.data[idx])
+		return false
+	})
+	return drained
+}
+
+// compact removes the elements for which keep returns false, given their index in the
+// backing slice, and moves the others towards the front in place, keeping their order.
+// It returns the number of removed elements. The caller must hold the write lock.
+//
+// Complexity: O(n)
+func (q *Queue[T]) compact(keep func(idx int) bool) int {
+	var zero T
+	kept := 0
+	for i := 0; i < q.count; i++ {
+		from := (q.front + i) % q.cap
+		if !keep(from) {
+			continue
+		}
+		to := (q.front + kept) % q.cap
+		q.data[to] = q.data[from]
+		if q.expiry != nil {
+			q.expiry[to] = q.expiry[from]
+		}
+		kept++
+	}
+	for i := kept; i < q.count; i++ {
+		idx := (q.front + i) % q.cap
+		q.data[idx] = zero
+		if q.expiry != nil {
+			q.expiry[idx] = time.Time{}
+		}
+	}
+	removed := q.count - kept
+	q.count = kept
+	q.rear = (q.front + kept) % q.cap
+	q.shrinkIfSparse()
+	return removed
+}
+
 // Dequeue removes and returns the element from the front of the queue.
-// Returns an error if the queue is empty.
+// Returns ErrEmpty if the queue is empty.
 //
 // Algorithm Steps:
-//  1. If empty, return error.
-//  2. Retrieve element at the front index (mod cap).
+//  1. If empty, return ErrEmpty.
+//  2. Retrieve element at the front index.
 //  3. Clear the element (optional).
-//  4. Increment front and decrement count.
+//  4. Advance front (mod cap) and decrement count.
+//  5. If the element has expired, drop it and start over.
+//  6. Halve the capacity if the queue is at most a quarter full.
 //
-// Complexity: O(1)
+// Complexity: O(1) amortized, O(n) when resizing.
 func (q *Queue[T]) Dequeue() (T, error) {
 	var zero T
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
-	if q.count == 0 {
-		return zero, errors.New("queue empty")
+	defer q.shrinkIfSparse()
+	now := q.now()
+	for q.count > 0 {
+		value := q.data[q.front]
+		live := !q.expired(q.front, now)
+		q.data[q.front] = zero
+		q.popFront()
+		if live {
+			return value, nil
+		}
 	}
-	value := q.data[q.front%q.cap]
-	q.data[q.front%q.cap] = zero
-	q.front++
-	q.count--
-	return value, nil
+	return zero, ErrEmpty
 }
 
-// Peek returns the element at the front of the queue without removing it.
-// Returns an error if the queue is empty.
+// Peek returns the element at the front of the queue without removing it, skipping
+// expired elements. Returns ErrEmpty if the queue is empty.
 //
-// Complexity: O(1)
+// Complexity: O(1), plus O(k) for k expired elements at the front.
 func (q *Queue[T]) Peek() (T, error) {
 	var zero T
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
-	if q.count == 0 {
-		return zero, errors.New("queue empty")
+	now := q.now()
+	for i := 0; i < q.count; i++ {
+		if idx := (q.front + i) % q.cap; !q.expired(idx, now) {
+			return q.data[idx], nil
+		}
+	}
+	return zero, ErrEmpty
+}
+
+// PeekAt returns the element at position i of the queue (0 is the front) without
+// removing it, skipping expired elements. Returns ErrInvalidIndex if i is out of range.
+//
+// Complexity: O(1), plus O(k) when k elements have expired.
+func (q *Queue[T]) PeekAt(i int) (T, error) {
+	var zero T
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	if i < 0 || i >= q.count {
+		return zero, ErrInvalidIndex
+	}
+	if q.expiry == nil {
+		return q.data[(q.front+i)%q.cap], nil
 	}
-	return q.data[q.front%q.cap], nil
+	now := q.now()
+	for j := 0; j < q.count; j++ {
+		idx := (q.front + j) % q.cap
+		if q.expired(idx, now) {
+			continue
+		}
+		if i == 0 {
+			return q.data[idx], nil
+		}
+		i--
+	}
+	return zero, ErrInvalidIndex
+}
+
+// PeekLast returns the most recently enqueued element without removing it, skipping
+// expired elements. Returns ErrEmpty if the queue is empty.
+//
+// Complexity: O(1), plus O(k) for k expired elements at the rear.
+func (q *Queue[T]) PeekLast() (T, error) {
+	var zero T
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	now := q.now()
+	for j := q.count - 1; j >= 0; j-- {
+		if idx := (q.front + j) % q.cap; !q.expired(idx, now) {
+			return q.data[idx], nil
+		}
+	}
+	return zero, ErrEmpty
 }
 
 // IsFull checks if the queue has reached its current capacity.
@@ -166,20 +413,23 @@ func (q *Queue[T]) IsFull() bool {
 	return q.count == q.cap
 }
 
-// IsEmpty checks if the queue contains no elements.
+// IsEmpty checks if the queue contains no elements that have not expired, so it agrees
+// with Peek and Dequeue.
 //
-// Complexity: O(1)
+// Complexity: O(1), or O(n) while elements with a TTL are queued.
 func (q *Queue[T]) IsEmpty() bool {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
-	return q.count == 0
+	return q.liveCount() == 0
 }
 
-// Size returns the current number of elements in the queue.
+// Size returns the current number of elements in the queue, not counting expired ones.
 //
-// Complexity: O(1)
+// Complexity: O(1), or O(n) while elements with a TTL are queued.
 func (q *Queue[T]) Size() int {
-	return q.count
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	return q.liveCount()
 }
 
 // Deprecated: Use ToArray instead. ToArray returns a []T which can be
@@ -196,11 +446,11 @@ func (q *Queue[T]) ToString() string {
 	defer q.mutex.Unlock()
 	var result strings.Builder
 	result.WriteString("[")
-	for r := q.front; r <= q.rear-1; r++ {
-		value := q.data[r%q.cap]
+	values := q.live()
+	for i, value := range values {
 		str := fmt.Sprint(value)
 		result.WriteString(str)
-		if r != q.rear-1 {
+		if i != len(values)-1 {
 			result.WriteString(", ")
 		}
 	}
@@ -209,19 +459,23 @@ func (q *Queue[T]) ToString() string {
 }
 
 // Clear removes all elements from the queue and resets it to the initial state.
-// The capacity remains unchanged.
+// The backing slice is replaced by a new one of the initial capacity, so the old
+// elements are no longer referenced and can be garbage collected.
 //
-// Complexity: O(1)
+// Complexity: O(c), where c = initial capacity.
 func (q *Queue[T]) Clear() {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 	q.front = 0
 	q.rear = 0
 	q.count = 0
-	q.cap = 16
+	q.cap = q.minCap
+	q.data = make([]T, q.minCap)
+	q.expiry = nil
 }
 
-// ToArray returns a array representation of the queue elements in FIFO order.
+// ToArray returns a array representation of the queue elements in FIFO order,
+// skipping expired elements.
 //
 // Example output:
 //
@@ -231,10 +485,100 @@ func (q *Queue[T]) Clear() {
 func (q *Queue[T]) ToArray() []T {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
-	var result []T
-	for r := q.front; r <= q.rear-1; r++ {
-		value := q.data[r%q.cap]
-		result = append(result, value)
+	return q.live()
+}
+
+// snapshot copies the live elements in FIFO order, skipping the unused slots of the
+// backing slice. The caller must hold the lock.
+//
+// Complexity: O(n)
+func (q *Queue[T]) snapshot() []T {
+	result := make([]T, q.count)
+	// the live window is data[front:] followed, if it wraps, by data[:rear]
+	n := copy(result, q.data[q.front:min(q.front+q.count, q.cap)])
+	copy(result[n:], q.data[:q.count-n])
+	return result
+}
+
+// Clone returns an independent copy of the queue, with the same elements, TTLs and
+// initial capacity. The copy's backing slice only holds the live window, starting at
+// index 0.
+//
+// Complexity: O(n)
+func (q *Queue[T]) Clone() *Queue[T] {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	c := NewQueueWithCapacity[T](q.minCap)
+	c.now = q.now
+	if q.count > c.cap {
+		c.cap = q.count
+		c.data = make([]T, c.cap)
+	}
+	copy(c.data, q.snapshot())
+	if q.expiry != nil {
+		c.expiry = make([]time.Time, c.cap)
+		for i := 0; i < q.count; i++ {
+			c.expiry[i] = q.expiry[(q.front+i)%q.cap]
+		}
+	}
+	c.count = q.count
+	c.rear = q.count % c.cap
+	return c
+}
+
+// Equal reports whether the queue and other would dequeue the same elements in the same
+// order. Expired elements are ignored; capacities are not compared.
+//
+// The queues are locked one after the other rather than together, so comparing two
+// queues that are modified concurrently compares two snapshots taken at different times.
+//
+// Complexity: O(n)
+func (q *Queue[T]) Equal(other *Queue[T]) bool {
+	if q == other {
+		return true
+	}
+	other.mutex.RLock()
+	theirs := other.live()
+	other.mutex.RUnlock()
+
+	q.mutex.RLock()
+	ours := q.live()
+	q.mutex.RUnlock()
+	return slices.Equal(ours, theirs)
+}
+
+// liveCount returns the number of elements that have not expired.
+// The caller must hold the lock.
+//
+// Complexity: O(1), or O(n) while elements with a TTL are queued.
+func (q *Queue[T]) liveCount() int {
+	if q.expiry == nil {
+		return q.count
+	}
+	now := q.now()
+	n := 0
+	for i := 0; i < q.count; i++ {
+		if !q.expired((q.front+i)%q.cap, now) {
+			n++
+		}
+	}
+	return n
+}
+
+// live returns the elements that have not expired, in FIFO order.
+// The caller must hold the lock.
+//
+// Complexity: O(n)
+func (q *Queue[T]) live() []T {
+	if q.expiry == nil {
+		return q.snapshot()
+	}
+	now := q.now()
+	result := make([]T, 0, q.count)
+	for i := 0; i < q.count; i++ {
+		if idx := (q.front + i) % q.cap; !q.expired(idx, now) {
+			result = append(result, q.data[idx])
+		}
 	}
 	return result
 }
@@ -250,7 +594,8 @@ type Iterator[T comparable] struct {
 	data []T
 }
 
-// Iterator returns a snapshot of the queue elements in FIFO order.
+// Iterator returns a snapshot of the queue elements in FIFO order, skipping expired
+// elements.
 //
 // # Use Next() to iterate values
 //
@@ -258,12 +603,33 @@ type Iterator[T comparable] struct {
 func (q *Queue[T]) Iterator() *Iterator[T] {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
+	return &Iterator[T]{data: q.live(), idx: 0}
+}
 
-	// copy snapshot
-	snapshot := make([]T, q.Size())
-	copy(snapshot, q.data)
-
-	return &Iterator[T]{data: snapshot, idx: 0}
+// All returns an iterator over a snapshot of the queue elements in FIFO order,
+// skipping expired elements, for use with range-over-func.
+//
+// The snapshot is taken when the loop starts and no lock is held while the loop body
+// runs, so the body may modify the queue; such changes are not seen by the loop.
+//
+// Example:
+//
+//	for v := range q.All() {
+//	    fmt.Println(v)
+//	}
+//
+// Complexity: O(n)
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		q.mutex.RLock()
+		snapshot := q.live()
+		q.mutex.RUnlock()
+		for _, v := range snapshot {
+			if !yield(v) {
+				return
+			}
+		}
+	}
 }
 
 // Next return queue elements in FIFO order
@@ -132,3 +132,15 @@ func BenchmarkEnqueueLarge(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkEnqueueAllDequeueN(b *testing.B) {
+	data := generateData(64)
+	q := NewQueue[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.EnqueueAll(data...)
+		_ = q.DequeueN(len(data))
+	}
+}
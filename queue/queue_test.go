@@ -1,8 +1,10 @@
 package queue
 
 import (
+	"errors"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -92,3 +94,340 @@ func TestIterator(t *testing.T) {
 		t.Errorf("Expected %v, Got %v\n", str, actualStr)
 	}
 }
+
+func TestEnqueueAllAndDequeueN(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(0)
+	q.EnqueueAll(generateData(40)[1:]...)
+	q.EnqueueAll()
+	if q.Size() != 40 {
+		t.Errorf("Expected %v, got %v\n", 40, q.Size())
+	}
+
+	if got := q.DequeueN(3); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("DequeueN(3) = %v; want [0 1 2]", got)
+	}
+	if got := q.DequeueN(0); len(got) != 0 {
+		t.Errorf("DequeueN(0) = %v; want []", got)
+	}
+	got := q.DequeueN(100)
+	if !reflect.DeepEqual(got, generateData(40)[3:]) {
+		t.Errorf("DequeueN(100) = %v", got)
+	}
+	if !q.IsEmpty() || len(q.DequeueN(1)) != 0 {
+		t.Errorf("Expected empty queue after draining")
+	}
+}
+
+func TestEnqueueAllWrapped(t *testing.T) {
+	q := NewQueue[int]()
+	// move front and rear past the end of the backing slice, then grow
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+	q.DequeueN(10)
+	q.EnqueueAll(generateData(12)...)
+	q.EnqueueAll(generateData(30)...)
+	want := append(generateData(12), generateData(30)...)
+	if got := q.ToArray(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToArray() = %v; want %v", got, want)
+	}
+}
+
+func TestNewQueueWithCapacity(t *testing.T) {
+	q := NewQueueWithCapacity[int](100)
+	for i := 0; i < 100; i++ {
+		q.Enqueue(i)
+	}
+	if !q.IsFull() || q.cap != 100 {
+		t.Errorf("Expected a full queue of capacity 100, got capacity %v", q.cap)
+	}
+	q.Enqueue(100)
+	if q.cap != 200 {
+		t.Errorf("Expected capacity %v, got %v", 200, q.cap)
+	}
+	if NewQueueWithCapacity[int](0).cap != 16 {
+		t.Errorf("Expected capacity below 1 to default to 16")
+	}
+}
+
+func TestQueueShrink(t *testing.T) {
+	q := NewQueue[*int]()
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(&i)
+	}
+	q.DequeueN(900)
+	if q.cap >= 1024 {
+		t.Errorf("Expected auto-shrink after dequeuing, capacity %v", q.cap)
+	}
+	q.Shrink()
+	if q.cap != 100 || q.Size() != 100 {
+		t.Errorf("Expected capacity %v after Shrink, got %v", 100, q.cap)
+	}
+	// never below the initial capacity
+	q.DequeueN(95)
+	q.Shrink()
+	if q.cap != 16 || q.Size() != 5 {
+		t.Errorf("Expected capacity %v after Shrink, got %v", 16, q.cap)
+	}
+	for i := 0; i < 5; i++ {
+		if v, err := q.Dequeue(); err != nil || v == nil {
+			t.Errorf("Expected element after Shrink, got %v, %v", v, err)
+		}
+	}
+}
+
+func TestQueueClearReleasesElements(t *testing.T) {
+	q := NewQueueWithCapacity[*string](4)
+	s := "payload"
+	for i := 0; i < 10; i++ {
+		q.Enqueue(&s)
+	}
+	q.Clear()
+	if q.cap != 4 || len(q.data) != 4 {
+		t.Errorf("Expected capacity %v after Clear, got %v", 4, q.cap)
+	}
+	for _, v := range q.data {
+		if v != nil {
+			t.Errorf("Expected no references after Clear")
+		}
+	}
+	q.EnqueueAll(&s, nil, &s)
+	if got := q.ToArray(); len(got) != 3 || got[1] != nil {
+		t.Errorf("Expected queue to be usable after Clear, got %v", got)
+	}
+}
+
+func TestQueueIndicesWrap(t *testing.T) {
+	q := NewQueueWithCapacity[int](4)
+	// cycle through the backing slice many times without growing it
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(i)
+		q.Enqueue(i + 1)
+		if v, err := q.Dequeue(); err != nil || v != i {
+			t.Fatalf("Dequeue() = %v, %v; want %v", v, err, i)
+		}
+		if v, _ := q.Dequeue(); v != i+1 {
+			t.Fatalf("Dequeue() = %v; want %v", v, i+1)
+		}
+		if q.front < 0 || q.front >= q.cap || q.rear < 0 || q.rear >= q.cap {
+			t.Fatalf("indices out of range: front %v, rear %v, cap %v", q.front, q.rear, q.cap)
+		}
+	}
+
+	// a wrapped window is reported in FIFO order
+	q.EnqueueAll(1, 2, 3)
+	_, _ = q.Dequeue()
+	q.EnqueueAll(4, 5)
+	if q.rear > q.front {
+		t.Fatalf("expected a wrapped window, front %v, rear %v", q.front, q.rear)
+	}
+	if got := q.ToArray(); !reflect.DeepEqual(got, []int{2, 3, 4, 5}) {
+		t.Errorf("ToArray() = %v; want [2 3 4 5]", got)
+	}
+	if got := q.ToString(); got != "[2, 3, 4, 5]" {
+		t.Errorf("ToString() = %v; want [2, 3, 4, 5]", got)
+	}
+
+	// a full queue has rear == front; Shrink to exactly count keeps that consistent
+	q.Shrink()
+	q.Enqueue(6)
+	if got := q.ToArray(); !reflect.DeepEqual(got, []int{2, 3, 4, 5, 6}) {
+		t.Errorf("ToArray() = %v; want [2 3 4 5 6]", got)
+	}
+}
+
+func TestIteratorWrapped(t *testing.T) {
+	q := NewQueueWithCapacity[int](8)
+	q.EnqueueAll(0, 1, 2, 3, 4, 5)
+	q.DequeueN(5)
+	q.EnqueueAll(6, 7, 8, 9)
+
+	var got []int
+	it := q.Iterator()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		got = append(got, v)
+	}
+	if want := []int{5, 6, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterator yielded %v; want %v", got, want)
+	}
+
+	// the iterator is a snapshot
+	q.Enqueue(10)
+	if _, ok := it.Next(); ok {
+		t.Errorf("Expected exhausted iterator")
+	}
+}
+
+func TestAll(t *testing.T) {
+	q := NewQueueWithCapacity[int](4)
+	q.EnqueueAll(1, 2, 3)
+	_, _ = q.Dequeue()
+	q.EnqueueAll(4, 5)
+
+	var got []int
+	for v := range q.All() {
+		got = append(got, v)
+		// the loop body may modify the queue
+		q.Enqueue(v * 10)
+	}
+	if want := []int{2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All yielded %v; want %v", got, want)
+	}
+
+	got = nil
+	for v := range q.All() {
+		if v > 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All with break yielded %v; want %v", got, want)
+	}
+	for range NewQueue[int]().All() {
+		t.Errorf("Expected no elements for empty queue")
+	}
+}
+
+func TestSizeDuringConcurrentUse(t *testing.T) {
+	q := NewQueue[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				q.Enqueue(i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if n := q.Size(); n < 0 {
+					t.Errorf("Size() = %v", n)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if q.Size() != 4000 {
+		t.Errorf("Expected %v, got %v", 4000, q.Size())
+	}
+}
+
+func TestPeekAtAndPeekLast(t *testing.T) {
+	q := NewQueueWithCapacity[int](4)
+	if _, err := q.PeekLast(); err == nil {
+		t.Errorf("Expected error on PeekLast for empty queue")
+	}
+	q.EnqueueAll(0, 1, 2)
+	_, _ = q.Dequeue()
+	q.EnqueueAll(3, 4)
+
+	for i, want := range []int{1, 2, 3, 4} {
+		if v, err := q.PeekAt(i); err != nil || v != want {
+			t.Errorf("PeekAt(%v) = %v, %v; want %v", i, v, err, want)
+		}
+	}
+	for _, i := range []int{-1, 4} {
+		if _, err := q.PeekAt(i); err == nil {
+			t.Errorf("Expected error for PeekAt(%v)", i)
+		}
+	}
+	if v, err := q.PeekLast(); err != nil || v != 4 {
+		t.Errorf("PeekLast() = %v, %v; want 4", v, err)
+	}
+	if q.Size() != 4 {
+		t.Errorf("Expected peeking not to remove elements, size %v", q.Size())
+	}
+}
+
+func TestCloneAndEqual(t *testing.T) {
+	q := NewQueueWithCapacity[int](4)
+	q.EnqueueAll(0, 1, 2)
+	_, _ = q.Dequeue()
+	q.EnqueueAll(3, 4)
+
+	c := q.Clone()
+	if !q.Equal(c) || !c.Equal(q) || !q.Equal(q) {
+		t.Errorf("Expected clone to equal the original")
+	}
+	if got := c.ToArray(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("clone ToArray() = %v; want [1 2 3 4]", got)
+	}
+
+	c.Enqueue(5)
+	if q.Equal(c) || q.Size() != 4 {
+		t.Errorf("Expected clone to be independent of the original")
+	}
+	_, _ = c.Dequeue()
+	if q.Equal(c) {
+		t.Errorf("Expected queues with different elements not to be equal")
+	}
+
+	// capacity and slot layout are not part of equality
+	other := NewQueue[int]()
+	other.EnqueueAll(1, 2, 3, 4)
+	if !other.Equal(q) {
+		t.Errorf("Expected queues with the same elements to be equal")
+	}
+	if !NewQueue[int]().Equal(NewQueue[int]().Clone()) {
+		t.Errorf("Expected empty queues to be equal")
+	}
+}
+
+func TestDrainIf(t *testing.T) {
+	type message struct {
+		client string
+		body   int
+	}
+	q := NewQueueWithCapacity[message](4)
+	q.Enqueue(message{"x", 0})
+	_, _ = q.Dequeue()
+	for i := 1; i <= 6; i++ {
+		client := "a"
+		if i%3 == 0 {
+			client = "b"
+		}
+		q.Enqueue(message{client, i})
+	}
+
+	got := q.DrainIf(func(m message) bool { return m.client == "b" })
+	if want := []message{{"b", 3}, {"b", 6}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DrainIf() = %v; want %v", got, want)
+	}
+	want := []message{{"a", 1}, {"a", 2}, {"a", 4}, {"a", 5}}
+	if rest := q.ToArray(); !reflect.DeepEqual(rest, want) {
+		t.Errorf("remaining = %v; want %v", rest, want)
+	}
+	if got := q.DrainIf(func(message) bool { return false }); got != nil {
+		t.Errorf("DrainIf() = %v; want nil", got)
+	}
+	q.Enqueue(message{"c", 7})
+	if v, _ := q.PeekLast(); v.body != 7 || q.Size() != 5 {
+		t.Errorf("Expected queue to be usable after DrainIf, got %v", q.ToArray())
+	}
+}
+
+func TestQueueSentinelErrors(t *testing.T) {
+	q := NewQueue[int]()
+	if _, err := q.Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Dequeue: expected ErrEmpty, got %v", err)
+	}
+	if _, err := q.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Peek: expected ErrEmpty, got %v", err)
+	}
+	if _, err := q.PeekLast(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("PeekLast: expected ErrEmpty, got %v", err)
+	}
+	q.Enqueue(1)
+	for _, i := range []int{-1, 1} {
+		if _, err := q.PeekAt(i); !errors.Is(err, ErrInvalidIndex) {
+			t.Errorf("PeekAt(%d): expected ErrInvalidIndex, got %v", i, err)
+		}
+	}
+	if _, _, err := NewFairQueue[string, int]().Dequeue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("FairQueue.Dequeue: expected ErrEmpty, got %v", err)
+	}
+}
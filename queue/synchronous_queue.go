@@ -0,0 +1,88 @@
+package queue
+
+import "context"
+
+// SynchronousQueue is a queue without capacity: every Enqueue waits for a Dequeue to
+// take its element, and the other way around, like Java's SynchronousQueue. It is
+// useful for hand-off between goroutines where buffering is undesirable.
+//
+// It is a thin wrapper around an unbuffered channel, which already provides exactly
+// this rendezvous, adding context cancellation and non-blocking variants.
+//
+// Fields:
+//   - handoff: the unbuffered channel elements are passed through
+//
+// Example:
+//
+//	sq := queue.NewSynchronousQueue[Task]()
+//	go func() {
+//	    for {
+//	        task, err := sq.Dequeue(ctx)
+//	        ...
+//	    }
+//	}()
+//	err := sq.Enqueue(ctx, task) // returns once a worker has taken the task
+type SynchronousQueue[T comparable] struct {
+	handoff chan T
+}
+
+// NewSynchronousQueue creates and returns a new SynchronousQueue.
+//
+// Complexity: O(1)
+func NewSynchronousQueue[T comparable]() *SynchronousQueue[T] {
+	return &SynchronousQueue[T]{handoff: make(chan T)}
+}
+
+// Enqueue hands val to a goroutine calling Dequeue, waiting until one takes it or ctx
+// is done. In the latter case it returns ctx.Err() and val is not delivered.
+//
+// Complexity: O(1), excluding the time spent waiting
+func (sq *SynchronousQueue[T]) Enqueue(ctx context.Context, val T) error {
+	select {
+	case sq.handoff <- val:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Dequeue takes an element from a goroutine calling Enqueue, waiting until one offers
+// an element or ctx is done. In the latter case it returns ctx.Err().
+//
+// Complexity: O(1), excluding the time spent waiting
+func (sq *SynchronousQueue[T]) Dequeue(ctx context.Context) (T, error) {
+	select {
+	case val := <-sq.handoff:
+		return val, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// TryEnqueue hands val to a goroutine already waiting in Dequeue, without waiting.
+// Returns false if no goroutine is waiting.
+//
+// Complexity: O(1)
+func (sq *SynchronousQueue[T]) TryEnqueue(val T) bool {
+	select {
+	case sq.handoff <- val:
+		return true
+	default:
+		return false
+	}
+}
+
+// TryDequeue takes an element from a goroutine already waiting in Enqueue, without
+// waiting. Returns false if no goroutine is waiting.
+//
+// Complexity: O(1)
+func (sq *SynchronousQueue[T]) TryDequeue() (T, bool) {
+	select {
+	case val := <-sq.handoff:
+		return val, true
+	default:
+		var zero T
+		return zero, false
+	}
+}
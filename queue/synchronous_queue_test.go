@@ -0,0 +1,75 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSynchronousQueueHandoff(t *testing.T) {
+	sq := NewSynchronousQueue[int]()
+	delivered := make(chan error)
+	go func() {
+		delivered <- sq.Enqueue(context.Background(), 42)
+	}()
+
+	// Enqueue does not return before a consumer takes the element
+	select {
+	case <-delivered:
+		t.Fatalf("Enqueue returned without a consumer")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	v, err := sq.Dequeue(context.Background())
+	if err != nil || v != 42 {
+		t.Fatalf("Dequeue() = %v, %v; want 42", v, err)
+	}
+	if err := <-delivered; err != nil {
+		t.Fatalf("Enqueue error: %v", err)
+	}
+}
+
+func TestSynchronousQueueCancelled(t *testing.T) {
+	sq := NewSynchronousQueue[string]()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := sq.Enqueue(ctx, "x"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded from Enqueue, got %v", err)
+	}
+	if _, err := sq.Dequeue(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded from Dequeue, got %v", err)
+	}
+}
+
+func TestSynchronousQueueTry(t *testing.T) {
+	sq := NewSynchronousQueue[int]()
+	if sq.TryEnqueue(1) {
+		t.Errorf("Expected TryEnqueue to fail without a waiting consumer")
+	}
+	if _, ok := sq.TryDequeue(); ok {
+		t.Errorf("Expected TryDequeue to fail without a waiting producer")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sq.Enqueue(context.Background(), 7)
+	}()
+	deadline := time.Now().Add(time.Second)
+	for {
+		if v, ok := sq.TryDequeue(); ok {
+			if v != 7 {
+				t.Errorf("TryDequeue() = %v; want 7", v)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("TryDequeue never met the waiting producer")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	wg.Wait()
+}
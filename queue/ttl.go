@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"time"
+
+	"github.com/Zubayear/ryushin/internal/periodic"
+)
+
+// EnqueueWithTTL adds an element to the rear of the queue that expires after ttl.
+//
+// Expired elements are never returned by Dequeue, DequeueN, Peek, ToArray or iteration,
+// and are not counted by Size and IsEmpty. They are dropped when they reach the front
+// of the queue, by PurgeExpired or by a running sweeper (see StartSweeper); until then
+// they only occupy capacity. A ttl <= 0 adds an element that is already expired.
+//
+// Example:
+//
+//	q.EnqueueWithTTL(request, 5*time.Second) // not worth handling after 5 seconds
+//
+// Complexity: O(1) amortized, O(n) when resizing.
+func (q *Queue[T]) EnqueueWithTTL(val T, ttl time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.count == q.cap {
+		q.increaseSize()
+	}
+	if q.expiry == nil {
+		q.expiry = make([]time.Time, q.cap)
+	}
+	q.data[q.rear] = val
+	q.expiry[q.rear] = q.now().Add(ttl)
+	q.rear = (q.rear + 1) % q.cap
+	q.count++
+}
+
+// PurgeExpired removes every expired element from the queue, keeping the order of the
+// others, and returns how many elements were removed.
+//
+// Complexity: O(n)
+func (q *Queue[T]) PurgeExpired() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.expiry == nil {
+		return 0
+	}
+	now := q.now()
+	return q.compact(func(idx int) bool { return !q.expired(idx, now) })
+}
+
+// StartSweeper starts a background goroutine that calls PurgeExpired every interval,
+// so expired elements are released even if they do not reach the front of the queue.
+//
+// It returns a function that stops the goroutine; calling it more than once is safe.
+// interval must be positive; StartSweeper panics otherwise.
+//
+// Example:
+//
+//	stop := q.StartSweeper(10 * time.Second)
+//	defer stop()
+func (q *Queue[T]) StartSweeper(interval time.Duration) (stop func()) {
+	return periodic.Start(interval, func() { q.PurgeExpired() })
+}
+
+// expired reports whether the element at index idx of the backing slice was enqueued
+// with a TTL that has run out. The caller must hold the lock.
+//
+// Complexity: O(1)
+func (q *Queue[T]) expired(idx int, now time.Time) bool {
+	return q.expiry != nil && !q.expiry[idx].IsZero() && !now.Before(q.expiry[idx])
+}
+
+// popFront advances front past the element at the front of the queue, forgetting its
+// expiry. The caller must hold the write lock and clear the element itself.
+//
+// Complexity: O(1)
+func (q *Queue[T]) popFront() {
+	if q.expiry != nil {
+		q.expiry[q.front] = time.Time{}
+	}
+	q.front = (q.front + 1) % q.cap
+	q.count--
+}
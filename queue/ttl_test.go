@@ -0,0 +1,208 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced clock for TTL tests.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestEnqueueWithTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	q := NewQueue[string]()
+	q.now = clock.Now
+
+	q.EnqueueWithTTL("a", time.Minute)
+	q.Enqueue("b")
+	q.EnqueueWithTTL("c", 2*time.Minute)
+	q.EnqueueWithTTL("d", time.Minute)
+
+	clock.now = clock.now.Add(time.Minute)
+	if v, err := q.Peek(); err != nil || v != "b" {
+		t.Errorf("Peek() = %v, %v; want b", v, err)
+	}
+	// expired elements no longer count, even before they are dropped
+	if q.Size() != 2 {
+		t.Errorf("Expected %v, got %v", 2, q.Size())
+	}
+	if v, err := q.Dequeue(); err != nil || v != "b" {
+		t.Errorf("Dequeue() = %v, %v; want b", v, err)
+	}
+	if got := q.DequeueN(5); !reflect.DeepEqual(got, []string{"c"}) {
+		t.Errorf("DequeueN(5) = %v; want [c]", got)
+	}
+	if _, err := q.Dequeue(); err == nil || !q.IsEmpty() {
+		t.Errorf("Expected empty queue, size %v", q.Size())
+	}
+
+	q.EnqueueWithTTL("e", time.Minute)
+	clock.now = clock.now.Add(time.Minute)
+	if _, err := q.Peek(); err == nil {
+		t.Errorf("Expected error on Peek with only expired elements")
+	}
+	if _, err := q.Dequeue(); err == nil || q.Size() != 0 {
+		t.Errorf("Expected expired element to be dropped by Dequeue")
+	}
+}
+
+func TestQueueExpiredHiddenFromViews(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	q := NewQueue[int]()
+	q.now = clock.Now
+	q.EnqueueWithTTL(1, time.Minute)
+	clock.now = clock.now.Add(time.Minute)
+
+	// the consumer loop must terminate when only expired elements are left
+	if !q.IsEmpty() || q.Size() != 0 {
+		t.Fatalf("Expected an empty queue, got size %v", q.Size())
+	}
+	if _, err := q.Peek(); err == nil {
+		t.Errorf("Expected Peek to agree with IsEmpty")
+	}
+
+	q.Enqueue(2)
+	q.EnqueueWithTTL(3, time.Minute)
+	q.Enqueue(4)
+	clock.now = clock.now.Add(time.Minute)
+	want := []int{2, 4}
+	if got := q.ToArray(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToArray() = %v; want %v", got, want)
+	}
+	var got []int
+	for v := range q.All() {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v; want %v", got, want)
+	}
+	got = got[:0]
+	for it := q.Iterator(); ; {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterator() = %v; want %v", got, want)
+	}
+	if s := q.ToString(); s != "[2, 4]" {
+		t.Errorf("ToString() = %q; want [2, 4]", s)
+	}
+	if q.Size() != 2 || q.IsEmpty() {
+		t.Errorf("Expected size 2, got %v", q.Size())
+	}
+}
+
+func TestQueuePurgeExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	q := NewQueueWithCapacity[int](4)
+	q.now = clock.Now
+	if q.PurgeExpired() != 0 {
+		t.Errorf("Expected nothing to purge without TTLs")
+	}
+
+	// wrap the window around the end of the backing slice
+	q.EnqueueAll(0, 0)
+	q.DequeueN(2)
+	for i := 1; i <= 8; i++ {
+		if i%2 == 0 {
+			q.EnqueueWithTTL(i, time.Minute)
+		} else {
+			q.Enqueue(i)
+		}
+	}
+	clock.now = clock.now.Add(time.Minute)
+	if n := q.PurgeExpired(); n != 4 {
+		t.Errorf("PurgeExpired() = %v; want 4", n)
+	}
+	if got := q.ToArray(); !reflect.DeepEqual(got, []int{1, 3, 5, 7}) {
+		t.Errorf("ToArray() = %v; want [1 3 5 7]", got)
+	}
+	q.Enqueue(9)
+	if got := q.DequeueN(10); !reflect.DeepEqual(got, []int{1, 3, 5, 7, 9}) {
+		t.Errorf("DequeueN(10) = %v; want [1 3 5 7 9]", got)
+	}
+}
+
+func TestQueueTTLSurvivesResize(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	q := NewQueueWithCapacity[int](2)
+	q.now = clock.Now
+	q.EnqueueWithTTL(1, time.Minute)
+	for i := 2; i <= 10; i++ {
+		q.Enqueue(i)
+	}
+	clock.now = clock.now.Add(time.Minute)
+	if v, _ := q.Dequeue(); v != 2 {
+		t.Errorf("Dequeue() = %v; want 2", v)
+	}
+	q.Clear()
+	q.Enqueue(11)
+	if v, _ := q.Dequeue(); v != 11 {
+		t.Errorf("Dequeue() = %v; want 11", v)
+	}
+}
+
+func TestQueueStartSweeper(t *testing.T) {
+	q := NewQueue[int]()
+	q.EnqueueWithTTL(1, time.Millisecond)
+	q.Enqueue(2)
+	stop := q.StartSweeper(time.Millisecond)
+	defer stop()
+
+	deadline := time.Now().Add(time.Second)
+	for q.Size() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("sweeper did not purge the expired element")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+	stop()
+}
+
+func TestPeekAtSkipsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	q := NewQueue[string]()
+	q.now = clock.Now
+	q.EnqueueWithTTL("a", time.Minute)
+	q.Enqueue("b")
+	q.EnqueueWithTTL("c", time.Minute)
+	q.Enqueue("d")
+	q.EnqueueWithTTL("e", time.Minute)
+	clock.now = clock.now.Add(time.Minute)
+
+	if v, _ := q.PeekAt(1); v != "d" {
+		t.Errorf("PeekAt(1) = %v; want d", v)
+	}
+	if _, err := q.PeekAt(2); err == nil {
+		t.Errorf("Expected error for PeekAt past the live elements")
+	}
+	if v, _ := q.PeekLast(); v != "d" {
+		t.Errorf("PeekLast() = %v; want d", v)
+	}
+}
+
+func TestCloneKeepsTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	q := NewQueue[string]()
+	q.now = clock.Now
+	q.EnqueueWithTTL("a", time.Minute)
+	q.Enqueue("b")
+	c := q.Clone()
+
+	plain := NewQueue[string]()
+	plain.Enqueue("b")
+	clock.now = clock.now.Add(time.Minute)
+	if !c.Equal(plain) || !q.Equal(plain) {
+		t.Errorf("Expected expired elements to be ignored by Equal")
+	}
+	if v, _ := c.Dequeue(); v != "b" {
+		t.Errorf("clone Dequeue() = %v; want b", v)
+	}
+}
@@ -0,0 +1,78 @@
+package set
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitSet(t *testing.T) {
+	bs := NewBitSet(10)
+	for _, i := range []int{0, 3, 63, 64, 200} {
+		if !bs.Set(i) {
+			t.Errorf("Set(%d): expected true", i)
+		}
+	}
+	if bs.Set(3) {
+		t.Errorf("Set(3) twice: expected false")
+	}
+	for i, want := range map[int]bool{0: true, 1: false, 63: true, 64: true, 65: false, 200: true, 1000: false, -1: false} {
+		if got := bs.Test(i); got != want {
+			t.Errorf("Test(%d) = %v; want %v", i, got, want)
+		}
+	}
+	if bs.Count() != 5 {
+		t.Errorf("Expected Count 5, got %d", bs.Count())
+	}
+	if got := bs.Items(); !reflect.DeepEqual(got, []int{0, 3, 63, 64, 200}) {
+		t.Errorf("Items = %v", got)
+	}
+
+	if !bs.Unset(63) || bs.Unset(63) || bs.Unset(5000) || bs.Test(63) {
+		t.Errorf("Unset: expected true once for present members only")
+	}
+
+	var got []int
+	for i := range bs.All() {
+		got = append(got, i)
+		if i == 3 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(got, []int{0, 3}) {
+		t.Errorf("All with early exit = %v; want [0 3]", got)
+	}
+
+	bs.Clear()
+	if bs.Count() != 0 || bs.Test(0) {
+		t.Errorf("Expected empty bitset after Clear")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Set(-1) to panic")
+		}
+	}()
+	bs.Set(-1)
+}
+
+func TestBitSet_UnionIntersect(t *testing.T) {
+	a, b := NewBitSet(0), NewBitSet(0)
+	for _, i := range []int{1, 2, 70, 300} {
+		a.Set(i)
+	}
+	for _, i := range []int{2, 70, 71} {
+		b.Set(i)
+	}
+	if got := a.Union(b).Items(); !reflect.DeepEqual(got, []int{1, 2, 70, 71, 300}) {
+		t.Errorf("Union = %v", got)
+	}
+	if got := b.Union(a).Items(); !reflect.DeepEqual(got, []int{1, 2, 70, 71, 300}) {
+		t.Errorf("Union reversed = %v", got)
+	}
+	if got := a.Intersect(b).Items(); !reflect.DeepEqual(got, []int{2, 70}) {
+		t.Errorf("Intersect = %v", got)
+	}
+	if a.Count() != 4 || b.Count() != 3 {
+		t.Errorf("Expected operands unchanged")
+	}
+}
@@ -0,0 +1,174 @@
+package set
+
+import (
+	"hash/maphash"
+	"math"
+	"math/bits"
+	"sync"
+)
+
+// defaultFPP is the false positive probability used when an invalid one is given.
+const defaultFPP = 0.01
+
+// BloomFilter is a probabilistic set for memory-bounded membership tests over huge key
+// spaces. MayContain never reports false for an added element, but may report true
+// for an element that was never added, with a probability close to the target false
+// positive probability (FPP) as long as no more than the expected number of elements
+// are added. Elements cannot be removed. It is safe for concurrent use.
+//
+// A filter sized for n elements and FPP p uses about -n*ln(p)/ln(2)^2 bits, e.g.
+// 9.6 bits per element for p = 1%, however large the elements are.
+//
+// When false positives are not acceptable, the filter can guard a slower exact
+// lookup, e.g. in an UnorderedSet, so that most absent elements skip it:
+//
+//	bf := set.NewBloomFilter[string](1_000_000, 0.01)
+//	bf.AddSet(known)
+//	if bf.MayContain(key) && known.Contain(key) {
+//	    ...
+//	}
+type BloomFilter[T comparable] struct {
+	lockObj sync.RWMutex
+	words   []uint64
+	m       uint64 // number of bits
+	k       int    // number of hash functions
+	hash    func(T) uint64
+}
+
+// NewBloomFilter creates an empty BloomFilter sized for expected elements at the
+// target false positive probability fpp, hashing elements with hash/maphash.
+//
+// If expected < 1, it is treated as 1. If fpp is not in (0, 1), 0.01 is used.
+//
+// Time Complexity: O(m/64), where m = number of bits
+func NewBloomFilter[T comparable](expected int, fpp float64) *BloomFilter[T] {
+	seed := maphash.MakeSeed()
+	return NewBloomFilterWithHash(expected, fpp, func(item T) uint64 {
+		return maphash.Comparable(seed, item)
+	})
+}
+
+// NewBloomFilterWithHash is like NewBloomFilter but hashes elements with hash, e.g.
+// to share filters between processes with a stable hash. hash should spread its
+// results over all 64 bits; the k bit positions are derived from its single result
+// by double hashing.
+//
+// Time Complexity: O(m/64), where m = number of bits
+func NewBloomFilterWithHash[T comparable](expected int, fpp float64, hash func(T) uint64) *BloomFilter[T] {
+	n := float64(max(expected, 1))
+	if fpp <= 0 || fpp >= 1 {
+		fpp = defaultFPP
+	}
+	m := max(uint64(math.Ceil(-n*math.Log(fpp)/(math.Ln2*math.Ln2))), 64)
+	k := max(int(math.Round(float64(m)/n*math.Ln2)), 1)
+	return &BloomFilter[T]{
+		words: make([]uint64, (m+63)/64),
+		m:     m,
+		k:     k,
+		hash:  hash,
+	}
+}
+
+// positions calls visit with the k bit positions of item, stopping early if visit
+// returns false.
+//
+// Algorithm: Kirsch-Mitzenmacher double hashing, g_i = h1 + i*h2 mod m, with h2 made
+// odd and derived from h1 by a 64-bit mixing step.
+func (bf *BloomFilter[T]) positions(item T, visit func(pos uint64) bool) {
+	h1 := bf.hash(item)
+	h2 := h1 * 0x9e3779b97f4a7c15
+	h2 = (h2^(h2>>32))*0xbf58476d1ce4e5b9 | 1
+	for i := 0; i < bf.k; i++ {
+		if !visit((h1 + uint64(i)*h2) % bf.m) {
+			return
+		}
+	}
+}
+
+// Add adds item to the filter.
+//
+// Time Complexity: O(k), where k = number of hash functions
+func (bf *BloomFilter[T]) Add(item T) {
+	bf.lockObj.Lock()
+	defer bf.lockObj.Unlock()
+	bf.add(item)
+}
+
+// add sets the bits of item. The caller must hold the lock.
+func (bf *BloomFilter[T]) add(item T) {
+	bf.positions(item, func(pos uint64) bool {
+		bf.words[pos/64] |= 1 << (pos % 64)
+		return true
+	})
+}
+
+// AddSet adds every element of s to the filter.
+//
+// Time Complexity: O(n*k), where n = number of elements in s
+func (bf *BloomFilter[T]) AddSet(s *UnorderedSet[T]) {
+	items := s.Items()
+	bf.lockObj.Lock()
+	defer bf.lockObj.Unlock()
+	for _, item := range items {
+		bf.add(item)
+	}
+}
+
+// MayContain reports whether item may have been added. false means item was
+// definitely never added; true means it probably was.
+//
+// Time Complexity: O(k)
+func (bf *BloomFilter[T]) MayContain(item T) bool {
+	bf.lockObj.RLock()
+	defer bf.lockObj.RUnlock()
+	found := true
+	bf.positions(item, func(pos uint64) bool {
+		found = bf.words[pos/64]&(1<<(pos%64)) != 0
+		return found
+	})
+	return found
+}
+
+// EstimateCount returns an estimate of the number of distinct elements added so far,
+// derived from the fraction of set bits. It loses precision once the filter holds
+// far more elements than it was sized for.
+//
+// Algorithm: n ≈ -(m/k) * ln(1 - X/m), where X = number of set bits.
+//
+// Time Complexity: O(m/64)
+func (bf *BloomFilter[T]) EstimateCount() int {
+	bf.lockObj.RLock()
+	defer bf.lockObj.RUnlock()
+	set := 0
+	for _, w := range bf.words {
+		set += bits.OnesCount64(w)
+	}
+	if uint64(set) >= bf.m {
+		return math.MaxInt
+	}
+	m := float64(bf.m)
+	return int(math.Round(-m / float64(bf.k) * math.Log(1-float64(set)/m)))
+}
+
+// Clear removes all elements from the filter.
+//
+// Time Complexity: O(m/64)
+func (bf *BloomFilter[T]) Clear() {
+	bf.lockObj.Lock()
+	defer bf.lockObj.Unlock()
+	clear(bf.words)
+}
+
+// Bits returns the number of bits of the filter.
+//
+// Time Complexity: O(1)
+func (bf *BloomFilter[T]) Bits() int {
+	return int(bf.m)
+}
+
+// HashCount returns the number of bit positions set per element.
+//
+// Time Complexity: O(1)
+func (bf *BloomFilter[T]) HashCount() int {
+	return bf.k
+}
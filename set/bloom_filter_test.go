@@ -0,0 +1,68 @@
+package set
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const n = 10000
+	bf := NewBloomFilter[string](n, 0.01)
+	// about 9.6 bits per element and 7 hash functions for 1%
+	if bf.Bits() < 95000 || bf.Bits() > 97000 || bf.HashCount() != 7 {
+		t.Errorf("Unexpected sizing: %d bits, %d hashes", bf.Bits(), bf.HashCount())
+	}
+
+	for i := 0; i < n; i++ {
+		bf.Add("in-" + strconv.Itoa(i))
+	}
+	for i := 0; i < n; i++ {
+		if !bf.MayContain("in-" + strconv.Itoa(i)) {
+			t.Fatalf("False negative for in-%d", i)
+		}
+	}
+	fp := 0
+	for i := 0; i < n; i++ {
+		if bf.MayContain("out-" + strconv.Itoa(i)) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / n; rate > 0.02 {
+		t.Errorf("False positive rate %.4f; want about 0.01", rate)
+	}
+
+	if est := bf.EstimateCount(); math.Abs(float64(est-n)) > n*0.05 {
+		t.Errorf("EstimateCount = %d; want about %d", est, n)
+	}
+
+	bf.Clear()
+	if bf.EstimateCount() != 0 || bf.MayContain("in-1") {
+		t.Errorf("Expected empty filter after Clear")
+	}
+}
+
+func TestBloomFilterWithHashAndSet(t *testing.T) {
+	calls := 0
+	bf := NewBloomFilterWithHash(100, -1, func(v int) uint64 {
+		calls++
+		return uint64(v) * 0x9e3779b97f4a7c15
+	})
+	exact := NewUnorderedSetOf(1, 2, 3)
+	bf.AddSet(exact)
+	if calls != 3 {
+		t.Errorf("Expected the custom hash to be called once per element, got %d", calls)
+	}
+	for _, v := range []int{1, 2, 3} {
+		if !bf.MayContain(v) {
+			t.Errorf("False negative for %d", v)
+		}
+	}
+	if est := bf.EstimateCount(); est != 3 {
+		t.Errorf("EstimateCount = %d; want 3", est)
+	}
+
+	if d := NewBloomFilter[int](0, 2); d.Bits() < 64 || d.HashCount() < 1 {
+		t.Errorf("Expected invalid arguments to fall back to a usable size, got %d bits", d.Bits())
+	}
+}
@@ -0,0 +1,149 @@
+package set
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// HashSet represents a generic set of elements of any type, including types that are
+// not comparable with == such as slices or structs holding slices. Membership is
+// decided by a user-supplied hash and equality function. It is safe for concurrent use.
+//
+// Elements are kept in buckets keyed by their hash; elements whose hashes collide are
+// told apart with eq, so hash only has to be consistent with eq: eq(a, b) must imply
+// hash(a) == hash(b). The elements must not be modified while they are in the set.
+//
+// Example usage:
+//
+//	paths := set.NewHashSet(
+//	    func(p []int) uint64 {
+//	        h := fnv.New64a()
+//	        for _, v := range p {
+//	            binary.Write(h, binary.LittleEndian, int64(v))
+//	        }
+//	        return h.Sum64()
+//	    },
+//	    slices.Equal[[]int],
+//	)
+//	paths.Insert([]int{1, 2, 3})
+//	paths.Contain([]int{1, 2, 3}) // true
+type HashSet[T any] struct {
+	lockObj sync.RWMutex
+	buckets map[uint64][]T
+	size    int
+	hash    func(T) uint64
+	eq      func(a, b T) bool
+}
+
+// NewHashSet creates and returns a new, empty HashSet using hash and eq to find
+// elements.
+//
+// Time Complexity: O(1)
+func NewHashSet[T any](hash func(T) uint64, eq func(a, b T) bool) *HashSet[T] {
+	return &HashSet[T]{buckets: make(map[uint64][]T), hash: hash, eq: eq}
+}
+
+// find returns the hash of item and its index in its bucket, or -1 if absent.
+// The caller must hold the lock.
+func (hs *HashSet[T]) find(item T) (uint64, int) {
+	h := hs.hash(item)
+	return h, slices.IndexFunc(hs.buckets[h], func(v T) bool { return hs.eq(v, item) })
+}
+
+// Insert adds an element to the set.
+// Returns true if the element was newly added, false if an equal one was present.
+// Algorithm: Hash the element and append it to its bucket unless an equal element is
+// already there. Lock acquired for writing.
+//
+// Time Complexity: O(1) average, O(b) for b elements with the same hash
+func (hs *HashSet[T]) Insert(item T) bool {
+	hs.lockObj.Lock()
+	defer hs.lockObj.Unlock()
+	h, i := hs.find(item)
+	if i >= 0 {
+		return false
+	}
+	hs.buckets[h] = append(hs.buckets[h], item)
+	hs.size++
+	return true
+}
+
+// Remove deletes the element equal to item from the set.
+// Returns true if an element was removed, false otherwise.
+// Algorithm: Hash the element and delete it from its bucket, dropping empty buckets.
+// Lock acquired for writing.
+//
+// Time Complexity: O(1) average, O(b) for b elements with the same hash
+func (hs *HashSet[T]) Remove(item T) bool {
+	hs.lockObj.Lock()
+	defer hs.lockObj.Unlock()
+	h, i := hs.find(item)
+	if i < 0 {
+		return false
+	}
+	if bucket := slices.Delete(hs.buckets[h], i, i+1); len(bucket) == 0 {
+		delete(hs.buckets, h)
+	} else {
+		hs.buckets[h] = bucket
+	}
+	hs.size--
+	return true
+}
+
+// Contain checks if an element equal to item exists in the set.
+//
+// Time Complexity: O(1) average, O(b) for b elements with the same hash
+func (hs *HashSet[T]) Contain(item T) bool {
+	hs.lockObj.RLock()
+	defer hs.lockObj.RUnlock()
+	_, i := hs.find(item)
+	return i >= 0
+}
+
+// Size returns the number of elements currently in the set.
+//
+// Time Complexity: O(1)
+func (hs *HashSet[T]) Size() int {
+	hs.lockObj.RLock()
+	defer hs.lockObj.RUnlock()
+	return hs.size
+}
+
+// Clear removes all elements from the set, resetting it to empty.
+//
+// Time Complexity: O(1)
+func (hs *HashSet[T]) Clear() {
+	hs.lockObj.Lock()
+	defer hs.lockObj.Unlock()
+	hs.buckets = make(map[uint64][]T)
+	hs.size = 0
+}
+
+// Items returns a slice containing all elements in the set.
+// The order of elements is not guaranteed.
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (hs *HashSet[T]) Items() []T {
+	hs.lockObj.RLock()
+	defer hs.lockObj.RUnlock()
+	items := make([]T, 0, hs.size)
+	for _, bucket := range hs.buckets {
+		items = append(items, bucket...)
+	}
+	return items
+}
+
+// All returns an iterator over a snapshot of the set elements, for use with
+// range-over-func. No lock is held while the loop body runs.
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (hs *HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range hs.Items() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+// sumHash is a deliberately weak hash so that different slices collide.
+func sumHash(s []int) uint64 {
+	var h uint64
+	for _, v := range s {
+		h += uint64(v)
+	}
+	return h
+}
+
+func TestHashSet(t *testing.T) {
+	hs := NewHashSet(sumHash, slices.Equal[[]int])
+	// {1, 2} and {3} and {2, 1} all hash to 3
+	for _, s := range [][]int{{1, 2}, {3}, {2, 1}, {}} {
+		if !hs.Insert(s) {
+			t.Errorf("Insert(%v): expected true", s)
+		}
+	}
+	if hs.Insert([]int{1, 2}) {
+		t.Errorf("Insert of an equal slice: expected false")
+	}
+	if hs.Size() != 4 || len(hs.Items()) != 4 {
+		t.Errorf("Expected 4 elements, got %d", hs.Size())
+	}
+	if !hs.Contain([]int{2, 1}) || hs.Contain([]int{1, 1, 1}) || !hs.Contain(nil) {
+		t.Errorf("Unexpected Contain results")
+	}
+
+	if !hs.Remove([]int{3}) || hs.Remove([]int{3}) {
+		t.Errorf("Remove: expected true once")
+	}
+	if !hs.Contain([]int{1, 2}) || !hs.Contain([]int{2, 1}) || hs.Size() != 3 {
+		t.Errorf("Expected colliding elements kept after Remove")
+	}
+	hs.Remove([]int{1, 2})
+	hs.Remove([]int{2, 1})
+	if len(hs.buckets) != 1 {
+		t.Errorf("Expected empty buckets to be dropped, got %d buckets", len(hs.buckets))
+	}
+
+	n := 0
+	for range hs.All() {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("Expected All to yield 1 element, got %d", n)
+	}
+
+	hs.Clear()
+	if hs.Size() != 0 || hs.Contain(nil) {
+		t.Errorf("Expected empty set after Clear")
+	}
+}
@@ -0,0 +1,130 @@
+package set
+
+import (
+	"iter"
+	"maps"
+)
+
+// KeySetView is a read-only set view of the keys of an existing map, so the map can
+// take part in set algebra with an UnorderedSet without copying its keys first.
+// It is created by a plain conversion and shares the map, so later changes to the map
+// are visible through the view:
+//
+//	stock := map[string]int{"apple": 3, "pear": 0, "plum": 7}
+//	wanted := set.NewUnorderedSetOf("apple", "kiwi")
+//	both := set.KeySetView[string, int](stock).Intersect(wanted) // {apple}
+//
+// A view takes no lock of its own: like the map itself, it must not be used while
+// another goroutine modifies the map. The UnorderedSet operands are locked as usual.
+type KeySetView[K comparable, V any] map[K]V
+
+// FromKeys creates and returns a new UnorderedSet holding the keys of m.
+//
+// Time Complexity: O(n), where n = len(m)
+func FromKeys[K comparable, V any](m map[K]V) *UnorderedSet[K] {
+	us := &UnorderedSet[K]{items: make(map[K]struct{}, len(m))}
+	for k := range m {
+		us.items[k] = struct{}{}
+	}
+	return us
+}
+
+// Contain checks if key is a key of the map.
+//
+// Time Complexity: O(1)
+func (v KeySetView[K, V]) Contain(key K) bool {
+	_, ok := v[key]
+	return ok
+}
+
+// Size returns the number of keys of the map.
+//
+// Time Complexity: O(1)
+func (v KeySetView[K, V]) Size() int {
+	return len(v)
+}
+
+// Items returns a slice containing the keys of the map.
+// The order of keys is not guaranteed.
+//
+// Time Complexity: O(n)
+func (v KeySetView[K, V]) Items() []K {
+	items := make([]K, 0, len(v))
+	for k := range v {
+		items = append(items, k)
+	}
+	return items
+}
+
+// All returns an iterator over the keys of the map, for use with range-over-func.
+//
+// Time Complexity: O(n)
+func (v KeySetView[K, V]) All() iter.Seq[K] {
+	return maps.Keys(v)
+}
+
+// Union returns a new set holding the keys of the map and the elements of other.
+// Neither the map nor other is modified.
+//
+// Time Complexity: O(n + m)
+func (v KeySetView[K, V]) Union(other *UnorderedSet[K]) *UnorderedSet[K] {
+	result := FromKeys(v)
+	other.lockObj.RLock()
+	defer other.lockObj.RUnlock()
+	maps.Copy(result.items, other.items)
+	return result
+}
+
+// Intersect returns a new set holding the keys of the map that are elements of other.
+// Algorithm: Walk the smaller of the two and look the elements up in the other one.
+//
+// Time Complexity: O(min(n, m))
+func (v KeySetView[K, V]) Intersect(other *UnorderedSet[K]) *UnorderedSet[K] {
+	other.lockObj.RLock()
+	defer other.lockObj.RUnlock()
+	result := NewUnorderedSet[K]()
+	if len(v) <= len(other.items) {
+		for k := range v {
+			if _, ok := other.items[k]; ok {
+				result.items[k] = struct{}{}
+			}
+		}
+		return result
+	}
+	for k := range other.items {
+		if _, ok := v[k]; ok {
+			result.items[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set holding the keys of the map that are not elements
+// of other.
+//
+// Time Complexity: O(n)
+func (v KeySetView[K, V]) Difference(other *UnorderedSet[K]) *UnorderedSet[K] {
+	other.lockObj.RLock()
+	defer other.lockObj.RUnlock()
+	result := NewUnorderedSet[K]()
+	for k := range v {
+		if _, ok := other.items[k]; !ok {
+			result.items[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubsetOf reports whether every key of the map is an element of other.
+//
+// Time Complexity: O(n)
+func (v KeySetView[K, V]) IsSubsetOf(other *UnorderedSet[K]) bool {
+	other.lockObj.RLock()
+	defer other.lockObj.RUnlock()
+	for k := range v {
+		if _, ok := other.items[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
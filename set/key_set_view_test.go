@@ -0,0 +1,60 @@
+package set
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKeySetView(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c"}
+	view := KeySetView[int, string](m)
+	other := NewUnorderedSetOf(2, 3, 4)
+
+	if !view.Contain(1) || view.Contain(4) || view.Size() != 3 {
+		t.Errorf("Unexpected view contents")
+	}
+	keys := view.Items()
+	sort.Ints(keys)
+	if !reflect.DeepEqual(keys, []int{1, 2, 3}) {
+		t.Errorf("Items = %v; want [1 2 3]", keys)
+	}
+	n := 0
+	for range view.All() {
+		n++
+	}
+	if n != 3 {
+		t.Errorf("All yielded %d keys; want 3", n)
+	}
+
+	tests := []struct {
+		name string
+		got  *UnorderedSet[int]
+		want []int
+	}{
+		{"Union", view.Union(other), []int{1, 2, 3, 4}},
+		{"Intersect", view.Intersect(other), []int{2, 3}},
+		{"Intersect larger other", view.Intersect(NewUnorderedSetOf(0, 1, 5, 6, 7)), []int{1}},
+		{"Difference", view.Difference(other), []int{1}},
+		{"FromKeys", FromKeys(m), []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		if got := sortedItems(tt.got); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %v; want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if view.IsSubsetOf(other) || !view.IsSubsetOf(NewUnorderedSetOf(0, 1, 2, 3)) {
+		t.Errorf("Unexpected IsSubsetOf results")
+	}
+
+	// the view shares the map, FromKeys copies it
+	copied := FromKeys(m)
+	m[9] = "z"
+	if !view.Contain(9) || copied.Contain(9) {
+		t.Errorf("Expected the view to see the new key and the copy not to")
+	}
+	if other.Size() != 3 {
+		t.Errorf("Expected other unchanged, got %v", other.Items())
+	}
+}
@@ -0,0 +1,168 @@
+package set
+
+import (
+	"maps"
+	"sync"
+)
+
+// MultiSet represents a generic multiset (bag): an unordered collection that, unlike
+// UnorderedSet, remembers how many times each element was added. It is safe for
+// concurrent use.
+//
+// Example usage:
+//
+//	words := set.NewMultiSet[string]()
+//	for _, w := range strings.Fields("to be or not to be") {
+//	    words.Add(w)
+//	}
+//	words.Count("to")  // 2
+//	words.Size()       // 6
+//	words.Distinct()   // 4
+type MultiSet[T comparable] struct {
+	lockObj sync.RWMutex
+	counts  map[T]int
+	size    int
+}
+
+// NewMultiSet creates and returns a new, empty MultiSet.
+//
+// Time Complexity: O(1)
+func NewMultiSet[T comparable]() *MultiSet[T] {
+	return &MultiSet[T]{counts: make(map[T]int)}
+}
+
+// Add adds one occurrence of item to the multiset and returns its new count.
+// Algorithm: Increment the count in the map. Lock acquired for writing.
+//
+// Time Complexity: O(1) amortized
+func (ms *MultiSet[T]) Add(item T) int {
+	ms.lockObj.Lock()
+	defer ms.lockObj.Unlock()
+	ms.counts[item]++
+	ms.size++
+	return ms.counts[item]
+}
+
+// Count returns how many occurrences of item the multiset holds, 0 if none.
+//
+// Time Complexity: O(1)
+func (ms *MultiSet[T]) Count(item T) int {
+	ms.lockObj.RLock()
+	defer ms.lockObj.RUnlock()
+	return ms.counts[item]
+}
+
+// RemoveOne removes one occurrence of item from the multiset.
+// Returns true if an occurrence was removed, false if item was not present.
+// Algorithm: Decrement the count, deleting the key when it reaches 0.
+// Lock acquired for writing.
+//
+// Time Complexity: O(1)
+func (ms *MultiSet[T]) RemoveOne(item T) bool {
+	ms.lockObj.Lock()
+	defer ms.lockObj.Unlock()
+	n := ms.counts[item]
+	if n == 0 {
+		return false
+	}
+	if n == 1 {
+		delete(ms.counts, item)
+	} else {
+		ms.counts[item] = n - 1
+	}
+	ms.size--
+	return true
+}
+
+// RemoveAll removes every occurrence of item from the multiset and returns how many
+// were removed.
+//
+// Time Complexity: O(1)
+func (ms *MultiSet[T]) RemoveAll(item T) int {
+	ms.lockObj.Lock()
+	defer ms.lockObj.Unlock()
+	n := ms.counts[item]
+	delete(ms.counts, item)
+	ms.size -= n
+	return n
+}
+
+// Size returns the total number of occurrences in the multiset, counting duplicates.
+//
+// Time Complexity: O(1)
+func (ms *MultiSet[T]) Size() int {
+	ms.lockObj.RLock()
+	defer ms.lockObj.RUnlock()
+	return ms.size
+}
+
+// Distinct returns the number of distinct elements in the multiset.
+//
+// Time Complexity: O(1)
+func (ms *MultiSet[T]) Distinct() int {
+	ms.lockObj.RLock()
+	defer ms.lockObj.RUnlock()
+	return len(ms.counts)
+}
+
+// Clear removes all elements from the multiset, resetting it to empty.
+//
+// Time Complexity: O(1)
+func (ms *MultiSet[T]) Clear() {
+	ms.lockObj.Lock()
+	defer ms.lockObj.Unlock()
+	ms.counts = make(map[T]int)
+	ms.size = 0
+}
+
+// Counts returns a copy of the multiset as a map from each distinct element to its
+// count. The map is not retained, so the caller may modify it.
+//
+// Time Complexity: O(d), where d = number of distinct elements
+func (ms *MultiSet[T]) Counts() map[T]int {
+	ms.lockObj.RLock()
+	defer ms.lockObj.RUnlock()
+	return maps.Clone(ms.counts)
+}
+
+// Union returns a new multiset in which every element occurs as many times as in
+// whichever of ms and other holds more of it. Neither multiset is modified.
+// Algorithm: Copy ms, then raise each count to the count in other. Both multisets
+// are read-locked.
+//
+// Time Complexity: O(d + e), where d and e are the numbers of distinct elements
+func (ms *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
+	unlock := rlockPair(&ms.lockObj, &other.lockObj)
+	defer unlock()
+	result := &MultiSet[T]{counts: maps.Clone(ms.counts), size: ms.size}
+	for item, n := range other.counts {
+		if cur := result.counts[item]; n > cur {
+			result.counts[item] = n
+			result.size += n - cur
+		}
+	}
+	return result
+}
+
+// Intersect returns a new multiset in which every element occurs as many times as in
+// whichever of ms and other holds fewer of it. Neither multiset is modified.
+// Algorithm: Walk the smaller multiset and keep the minimum of both counts.
+// Both multisets are read-locked.
+//
+// Time Complexity: O(min(d, e))
+func (ms *MultiSet[T]) Intersect(other *MultiSet[T]) *MultiSet[T] {
+	unlock := rlockPair(&ms.lockObj, &other.lockObj)
+	defer unlock()
+	small, large := ms.counts, other.counts
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	result := NewMultiSet[T]()
+	for item, n := range small {
+		if m := min(n, large[item]); m > 0 {
+			result.counts[item] = m
+			result.size += m
+		}
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package set
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newMultiSet returns a multiset holding one occurrence per element of items.
+func newMultiSet(items ...string) *MultiSet[string] {
+	ms := NewMultiSet[string]()
+	for _, item := range items {
+		ms.Add(item)
+	}
+	return ms
+}
+
+func TestMultiSet_Counting(t *testing.T) {
+	ms := newMultiSet(strings.Fields("to be or not to be to")...)
+	if ms.Count("to") != 3 || ms.Count("be") != 2 || ms.Count("or") != 1 || ms.Count("xyz") != 0 {
+		t.Errorf("Unexpected counts %v", ms.Counts())
+	}
+	if ms.Size() != 7 || ms.Distinct() != 4 {
+		t.Errorf("Expected size 7 and 4 distinct, got %d and %d", ms.Size(), ms.Distinct())
+	}
+	if n := ms.Add("or"); n != 2 {
+		t.Errorf("Add: expected new count 2, got %d", n)
+	}
+
+	if !ms.RemoveOne("be") || ms.Count("be") != 1 {
+		t.Errorf("RemoveOne: expected one 'be' left, got %d", ms.Count("be"))
+	}
+	if !ms.RemoveOne("be") || ms.RemoveOne("be") || ms.Distinct() != 3 {
+		t.Errorf("RemoveOne: expected 'be' gone, got %v", ms.Counts())
+	}
+	if n := ms.RemoveAll("to"); n != 3 || ms.Count("to") != 0 {
+		t.Errorf("RemoveAll: expected 3 removed, got %d", n)
+	}
+	if n := ms.RemoveAll("to"); n != 0 {
+		t.Errorf("RemoveAll: expected 0 removed the second time, got %d", n)
+	}
+	if want := map[string]int{"or": 2, "not": 1}; !reflect.DeepEqual(ms.Counts(), want) || ms.Size() != 3 {
+		t.Errorf("Expected %v with size 3, got %v with size %d", want, ms.Counts(), ms.Size())
+	}
+
+	counts := ms.Counts()
+	counts["or"] = 100
+	if ms.Count("or") != 2 {
+		t.Errorf("Expected Counts to return a copy")
+	}
+
+	ms.Clear()
+	if ms.Size() != 0 || ms.Distinct() != 0 || ms.Count("or") != 0 {
+		t.Errorf("Expected empty multiset after Clear")
+	}
+}
+
+func TestMultiSet_UnionIntersect(t *testing.T) {
+	a := newMultiSet("x", "x", "x", "y", "z")
+	b := newMultiSet("x", "y", "y", "w")
+
+	u := a.Union(b)
+	if want := map[string]int{"x": 3, "y": 2, "z": 1, "w": 1}; !reflect.DeepEqual(u.Counts(), want) || u.Size() != 7 {
+		t.Errorf("Union = %v (size %d); want %v (size 7)", u.Counts(), u.Size(), want)
+	}
+	i := a.Intersect(b)
+	if want := map[string]int{"x": 1, "y": 1}; !reflect.DeepEqual(i.Counts(), want) || i.Size() != 2 {
+		t.Errorf("Intersect = %v (size %d); want %v (size 2)", i.Counts(), i.Size(), want)
+	}
+	if self := a.Union(a); !reflect.DeepEqual(self.Counts(), a.Counts()) {
+		t.Errorf("Union with self = %v; want %v", self.Counts(), a.Counts())
+	}
+
+	u.Add("x")
+	if a.Count("x") != 3 {
+		t.Errorf("Expected the result to be independent of the operands")
+	}
+}
+
+func TestMultiSet_Concurrent(t *testing.T) {
+	ms := NewMultiSet[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				ms.Add(i % 10)
+			}
+		}()
+	}
+	wg.Wait()
+	if ms.Size() != 8000 || ms.Count(3) != 800 {
+		t.Errorf("Expected size 8000 and count 800, got %d and %d", ms.Size(), ms.Count(3))
+	}
+}
@@ -0,0 +1,424 @@
+package set
+
+import (
+	"iter"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// rbNode is a node of the left-leaning red-black tree backing an OrderedSet.
+// red is the color of the link from the node's parent.
+type rbNode[T any] struct {
+	item        T
+	left, right *rbNode[T]
+	red         bool
+}
+
+// OrderedSet represents a generic sorted set data structure backed by a left-leaning
+// red-black tree. It stores unique elements, keeps them in ascending order and
+// ensures thread-safe operations.
+//
+// Unlike UnorderedSet, iteration is sorted and the set answers order queries such
+// as Min, Max, Floor, Ceiling and Range in O(log n).
+//
+// Example usage:
+//
+//	s := set.NewOrderedSet[int]()
+//	s.Insert(30)
+//	s.Insert(10)
+//	s.Insert(20)
+//	s.Items()       // [10 20 30]
+//	s.Floor(25)     // 20, true
+//	s.Range(10, 30) // [10 20]
+type OrderedSet[T any] struct {
+	lockObj sync.RWMutex
+	root    *rbNode[T]
+	size    int
+	less    func(a, b T) bool
+}
+
+// NewOrderedSet creates and returns a new, empty OrderedSet using the natural
+// ordering of T.
+//
+// Time Complexity: O(1)
+func NewOrderedSet[T constraints.Ordered]() *OrderedSet[T] {
+	return NewOrderedSetWithComparator(func(a, b T) bool { return a < b })
+}
+
+// NewOrderedSetWithComparator creates and returns a new, empty OrderedSet ordered by
+// less, which must be a strict weak ordering. Two elements for which neither is less
+// than the other are considered equal, and only one of them is stored.
+//
+// Time Complexity: O(1)
+func NewOrderedSetWithComparator[T any](less func(a, b T) bool) *OrderedSet[T] {
+	return &OrderedSet[T]{less: less}
+}
+
+// Insert adds an element to the set.
+// Returns true if the element was newly added, false if it was already present.
+// Algorithm: Red-black tree insertion, rebalancing on the way back up.
+// Lock acquired for writing.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Insert(item T) bool {
+	s.lockObj.Lock()
+	defer s.lockObj.Unlock()
+	var added bool
+	s.root = s.insert(s.root, item, &added)
+	s.root.red = false
+	if added {
+		s.size++
+	}
+	return added
+}
+
+// Remove deletes an element from the set.
+// Returns true if the element was present and removed, false otherwise.
+// Algorithm: Red-black tree deletion, rebalancing on the way back up.
+// Lock acquired for writing.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Remove(item T) bool {
+	s.lockObj.Lock()
+	defer s.lockObj.Unlock()
+	if s.find(item) == nil {
+		return false
+	}
+	if !isRed(s.root.left) && !isRed(s.root.right) {
+		s.root.red = true
+	}
+	s.root = s.delete(s.root, item)
+	if s.root != nil {
+		s.root.red = false
+	}
+	s.size--
+	return true
+}
+
+// Contain checks if an element exists in the set.
+// Returns true if present, false otherwise.
+// Algorithm: Binary search down the tree. Lock acquired for reading.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Contain(item T) bool {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	return s.find(item) != nil
+}
+
+// Size returns the number of elements currently in the set.
+//
+// Time Complexity: O(1)
+func (s *OrderedSet[T]) Size() int {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	return s.size
+}
+
+// Clear removes all elements from the set, resetting it to empty.
+//
+// Time Complexity: O(1)
+func (s *OrderedSet[T]) Clear() {
+	s.lockObj.Lock()
+	defer s.lockObj.Unlock()
+	s.root = nil
+	s.size = 0
+}
+
+// Items returns a slice containing all elements in the set in ascending order.
+// Algorithm: In-order traversal of the tree. Lock acquired for reading.
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (s *OrderedSet[T]) Items() []T {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	items := make([]T, 0, s.size)
+	s.ascend(s.root, nil, nil, func(item T) { items = append(items, item) })
+	return items
+}
+
+// All returns an iterator over a snapshot of the set elements in ascending order,
+// for use with range-over-func.
+//
+// The snapshot is taken when the loop starts and no lock is held while the loop body
+// runs, so the body may modify the set; such changes are not seen by the loop.
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.Items() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Min returns the smallest element of the set. Returns false if the set is empty.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Min() (T, bool) {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	if s.root == nil {
+		var zero T
+		return zero, false
+	}
+	return minNode(s.root).item, true
+}
+
+// Max returns the largest element of the set. Returns false if the set is empty.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Max() (T, bool) {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	h := s.root
+	if h == nil {
+		var zero T
+		return zero, false
+	}
+	for h.right != nil {
+		h = h.right
+	}
+	return h.item, true
+}
+
+// Floor returns the largest element less than or equal to item.
+// Returns false if every element is greater than item.
+// Algorithm: Binary search remembering the last node at or below item.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Floor(item T) (T, bool) {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	var found *rbNode[T]
+	for h := s.root; h != nil; {
+		if s.less(item, h.item) {
+			h = h.left
+		} else {
+			found = h
+			h = h.right
+		}
+	}
+	if found == nil {
+		var zero T
+		return zero, false
+	}
+	return found.item, true
+}
+
+// Ceiling returns the smallest element greater than or equal to item.
+// Returns false if every element is less than item.
+// Algorithm: Binary search remembering the last node at or above item.
+//
+// Time Complexity: O(log n)
+func (s *OrderedSet[T]) Ceiling(item T) (T, bool) {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	var found *rbNode[T]
+	for h := s.root; h != nil; {
+		if s.less(h.item, item) {
+			h = h.right
+		} else {
+			found = h
+			h = h.left
+		}
+	}
+	if found == nil {
+		var zero T
+		return zero, false
+	}
+	return found.item, true
+}
+
+// Range returns the elements x with from <= x < to in ascending order, like a
+// half-open slice expression. The result is empty if to is not greater than from.
+// Algorithm: In-order traversal skipping the subtrees outside the range.
+// Lock acquired for reading.
+//
+// Time Complexity: O(log n + k), where k = number of returned elements
+func (s *OrderedSet[T]) Range(from, to T) []T {
+	s.lockObj.RLock()
+	defer s.lockObj.RUnlock()
+	var items []T
+	s.ascend(s.root, &from, &to, func(item T) { items = append(items, item) })
+	return items
+}
+
+// ascend calls visit for the elements of the subtree rooted at h in ascending order,
+// skipping those below *from or at or above *to when the bounds are not nil.
+// The caller must hold the lock.
+func (s *OrderedSet[T]) ascend(h *rbNode[T], from, to *T, visit func(T)) {
+	if h == nil {
+		return
+	}
+	aboveFrom := from == nil || !s.less(h.item, *from)
+	belowTo := to == nil || s.less(h.item, *to)
+	if aboveFrom {
+		s.ascend(h.left, from, to, visit)
+	}
+	if aboveFrom && belowTo {
+		visit(h.item)
+	}
+	if belowTo {
+		s.ascend(h.right, from, to, visit)
+	}
+}
+
+// find returns the node holding item, or nil. The caller must hold the lock.
+func (s *OrderedSet[T]) find(item T) *rbNode[T] {
+	for h := s.root; h != nil; {
+		switch {
+		case s.less(item, h.item):
+			h = h.left
+		case s.less(h.item, item):
+			h = h.right
+		default:
+			return h
+		}
+	}
+	return nil
+}
+
+// insert adds item to the subtree rooted at h and returns the new subtree root,
+// setting *added if item was not present. The caller must hold the lock.
+func (s *OrderedSet[T]) insert(h *rbNode[T], item T, added *bool) *rbNode[T] {
+	if h == nil {
+		*added = true
+		return &rbNode[T]{item: item, red: true}
+	}
+	switch {
+	case s.less(item, h.item):
+		h.left = s.insert(h.left, item, added)
+	case s.less(h.item, item):
+		h.right = s.insert(h.right, item, added)
+	default:
+		return h
+	}
+	return balance(h)
+}
+
+// delete removes item, which must be present, from the subtree rooted at h and
+// returns the new subtree root. The caller must hold the lock.
+//
+// On the way down, moveRedLeft and moveRedRight make sure the current node or its
+// child is red, so the leaf that is finally removed is red and no black height
+// changes; balance restores the left-leaning invariants on the way back up.
+func (s *OrderedSet[T]) delete(h *rbNode[T], item T) *rbNode[T] {
+	if s.less(item, h.item) {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = s.delete(h.left, item)
+		return balance(h)
+	}
+	if isRed(h.left) {
+		h = rotateRight(h)
+	}
+	if !s.less(h.item, item) && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = moveRedRight(h)
+	}
+	if s.less(h.item, item) {
+		h.right = s.delete(h.right, item)
+	} else {
+		h.item = minNode(h.right).item
+		h.right = deleteMin(h.right)
+	}
+	return balance(h)
+}
+
+// deleteMin removes the smallest node of the subtree rooted at h and returns the new
+// subtree root.
+func deleteMin[T any](h *rbNode[T]) *rbNode[T] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+// minNode returns the leftmost node of the subtree rooted at h, which must not be nil.
+func minNode[T any](h *rbNode[T]) *rbNode[T] {
+	for h.left != nil {
+		h = h.left
+	}
+	return h
+}
+
+// isRed reports whether the link to h is red; nil links are black.
+func isRed[T any](h *rbNode[T]) bool {
+	return h != nil && h.red
+}
+
+// rotateLeft turns the right-leaning red link below h into a left-leaning one.
+func rotateLeft[T any](h *rbNode[T]) *rbNode[T] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+// rotateRight turns the left-leaning red link below h into a right-leaning one.
+func rotateRight[T any](h *rbNode[T]) *rbNode[T] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+// flipColors flips the colors of h and its two children, splitting or merging a
+// temporary 4-node.
+func flipColors[T any](h *rbNode[T]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+// moveRedLeft makes h.left or one of its children red, assuming h is red and both
+// h.left and h.left.left are black.
+func moveRedLeft[T any](h *rbNode[T]) *rbNode[T] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// moveRedRight makes h.right or one of its children red, assuming h is red and both
+// h.right and h.right.left are black.
+func moveRedRight[T any](h *rbNode[T]) *rbNode[T] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// balance restores the left-leaning red-black invariants at h.
+func balance[T any](h *rbNode[T]) *rbNode[T] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	return h
+}
@@ -0,0 +1,181 @@
+package set
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkRedBlack verifies the left-leaning red-black invariants and the ordering of
+// the tree behind s.
+func checkRedBlack[T any](t *testing.T, s *OrderedSet[T]) {
+	t.Helper()
+	if isRed(s.root) {
+		t.Fatalf("root is red")
+	}
+	var walk func(h *rbNode[T]) int
+	walk = func(h *rbNode[T]) int {
+		if h == nil {
+			return 1
+		}
+		if isRed(h.right) {
+			t.Fatalf("right-leaning red link at %v", h.item)
+		}
+		if isRed(h) && isRed(h.left) {
+			t.Fatalf("two red links in a row at %v", h.item)
+		}
+		if h.left != nil && !s.less(h.left.item, h.item) || h.right != nil && !s.less(h.item, h.right.item) {
+			t.Fatalf("order violated at %v", h.item)
+		}
+		lh, rh := walk(h.left), walk(h.right)
+		if lh != rh {
+			t.Fatalf("black heights differ at %v: %d != %d", h.item, lh, rh)
+		}
+		if !isRed(h) {
+			lh++
+		}
+		return lh
+	}
+	walk(s.root)
+}
+
+func TestOrderedSet_InsertRemove(t *testing.T) {
+	s := NewOrderedSet[int]()
+	for _, v := range []int{5, 3, 8, 1, 4, 7, 9, 2, 6} {
+		if !s.Insert(v) {
+			t.Errorf("Insert(%d): expected true", v)
+		}
+	}
+	if s.Insert(5) {
+		t.Errorf("Insert(5) twice: expected false")
+	}
+	if s.Size() != 9 {
+		t.Errorf("Expected size 9, got %d", s.Size())
+	}
+	if got := s.Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("Expected sorted items, got %v", got)
+	}
+	checkRedBlack(t, s)
+
+	if !s.Remove(5) || s.Remove(5) || s.Remove(42) {
+		t.Errorf("Remove: expected true once for present elements only")
+	}
+	if s.Contain(5) || !s.Contain(6) || s.Size() != 8 {
+		t.Errorf("Expected 5 removed, got %v", s.Items())
+	}
+	checkRedBlack(t, s)
+
+	s.Clear()
+	if s.Size() != 0 || len(s.Items()) != 0 || s.Remove(1) {
+		t.Errorf("Expected empty set after Clear")
+	}
+	s.Insert(1)
+	if got := s.Items(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Expected set usable after Clear, got %v", got)
+	}
+}
+
+func TestOrderedSet_RandomizedAgainstMap(t *testing.T) {
+	s := NewOrderedSet[int]()
+	ref := make(map[int]bool)
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 5000; i++ {
+		v := r.IntN(500)
+		if r.IntN(3) == 0 {
+			if got, want := s.Remove(v), ref[v]; got != want {
+				t.Fatalf("Remove(%d) = %v; want %v", v, got, want)
+			}
+			delete(ref, v)
+		} else {
+			if got, want := s.Insert(v), !ref[v]; got != want {
+				t.Fatalf("Insert(%d) = %v; want %v", v, got, want)
+			}
+			ref[v] = true
+		}
+		if i%250 == 0 {
+			checkRedBlack(t, s)
+		}
+	}
+	checkRedBlack(t, s)
+	want := make([]int, 0, len(ref))
+	for v := range ref {
+		want = append(want, v)
+	}
+	slices.Sort(want)
+	if got := s.Items(); !reflect.DeepEqual(got, want) || s.Size() != len(want) {
+		t.Errorf("Items mismatch: got %d elements, want %d", len(got), len(want))
+	}
+}
+
+func TestOrderedSet_OrderQueries(t *testing.T) {
+	s := NewOrderedSet[int]()
+	if _, ok := s.Min(); ok {
+		t.Errorf("Min: expected false on empty set")
+	}
+	if _, ok := s.Max(); ok {
+		t.Errorf("Max: expected false on empty set")
+	}
+	for _, v := range []int{10, 20, 30, 40, 50} {
+		s.Insert(v)
+	}
+	if v, ok := s.Min(); !ok || v != 10 {
+		t.Errorf("Min = %v, %v; want 10", v, ok)
+	}
+	if v, ok := s.Max(); !ok || v != 50 {
+		t.Errorf("Max = %v, %v; want 50", v, ok)
+	}
+
+	tests := []struct {
+		x                    int
+		floor, ceil          int
+		hasFloor, hasCeiling bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{25, 20, 30, true, true},
+		{50, 50, 50, true, true},
+		{55, 50, 0, true, false},
+	}
+	for _, tt := range tests {
+		if v, ok := s.Floor(tt.x); v != tt.floor || ok != tt.hasFloor {
+			t.Errorf("Floor(%d) = %v, %v; want %v, %v", tt.x, v, ok, tt.floor, tt.hasFloor)
+		}
+		if v, ok := s.Ceiling(tt.x); v != tt.ceil || ok != tt.hasCeiling {
+			t.Errorf("Ceiling(%d) = %v, %v; want %v, %v", tt.x, v, ok, tt.ceil, tt.hasCeiling)
+		}
+	}
+
+	if got := s.Range(20, 40); !reflect.DeepEqual(got, []int{20, 30}) {
+		t.Errorf("Range(20, 40) = %v; want [20 30]", got)
+	}
+	if got := s.Range(15, 100); !reflect.DeepEqual(got, []int{20, 30, 40, 50}) {
+		t.Errorf("Range(15, 100) = %v; want [20 30 40 50]", got)
+	}
+	if got := s.Range(30, 30); len(got) != 0 {
+		t.Errorf("Range(30, 30) = %v; want empty", got)
+	}
+}
+
+func TestOrderedSet_AllAndComparator(t *testing.T) {
+	// shortlex order: by length, then alphabetically
+	s := NewOrderedSetWithComparator(func(a, b string) bool {
+		return len(a) < len(b) || len(a) == len(b) && a < b
+	})
+	for _, w := range []string{"ccc", "a", "bb", "aa", "a"} {
+		s.Insert(w)
+	}
+	var got []string
+	for w := range s.All() {
+		got = append(got, w)
+		if w == "aa" {
+			break
+		}
+	}
+	if !reflect.DeepEqual(got, []string{"a", "aa"}) {
+		t.Errorf("All with early exit = %v; want [a aa]", got)
+	}
+	if want := []string{"a", "aa", "bb", "ccc"}; !reflect.DeepEqual(s.Items(), want) {
+		t.Errorf("Items = %v; want %v", s.Items(), want)
+	}
+}
@@ -0,0 +1,117 @@
+package set
+
+import (
+	"hash/maphash"
+	"runtime"
+)
+
+// ShardedSet is a concurrent set for write-heavy workloads that hashes its elements
+// across independent UnorderedSets (shards), so that goroutines inserting or removing
+// different elements rarely contend on the same mutex.
+//
+// Every element always maps to the same shard, so Insert, Remove and Contain behave
+// exactly like their UnorderedSet counterparts. Size and Items visit the shards one by
+// one and are therefore not atomic snapshots under concurrent modification.
+//
+// Example:
+//
+//	seen := set.NewShardedSet[string](0)
+//	for range workers {
+//	    go func() {
+//	        for url := range urls {
+//	            if seen.Insert(url) {
+//	                crawl(url)
+//	            }
+//	        }
+//	    }()
+//	}
+type ShardedSet[T comparable] struct {
+	shards []*UnorderedSet[T]
+	seed   maphash.Seed
+}
+
+// NewShardedSet creates an empty ShardedSet with the given number of shards.
+//
+// If shards <= 0, the number of shards defaults to runtime.GOMAXPROCS(0).
+//
+// Time Complexity: O(s), where s = number of shards
+func NewShardedSet[T comparable](shards int) *ShardedSet[T] {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	ss := &ShardedSet[T]{shards: make([]*UnorderedSet[T], shards), seed: maphash.MakeSeed()}
+	for i := range ss.shards {
+		ss.shards[i] = NewUnorderedSet[T]()
+	}
+	return ss
+}
+
+// shard returns the shard holding item.
+//
+// Time Complexity: O(1)
+func (ss *ShardedSet[T]) shard(item T) *UnorderedSet[T] {
+	return ss.shards[maphash.Comparable(ss.seed, item)%uint64(len(ss.shards))]
+}
+
+// Insert adds an element to its shard.
+// Returns true if the element was newly added, false if it was already present.
+//
+// Time Complexity: O(1) amortized
+func (ss *ShardedSet[T]) Insert(item T) bool {
+	return ss.shard(item).Insert(item)
+}
+
+// Remove deletes an element from its shard.
+// Returns true if the element was present and removed, false otherwise.
+//
+// Time Complexity: O(1)
+func (ss *ShardedSet[T]) Remove(item T) bool {
+	return ss.shard(item).Remove(item)
+}
+
+// Contain checks if an element exists in the set.
+//
+// Time Complexity: O(1)
+func (ss *ShardedSet[T]) Contain(item T) bool {
+	return ss.shard(item).Contain(item)
+}
+
+// Size returns the total number of elements in all shards. Under concurrent
+// modification the result is approximate, since the shards are counted one by one.
+//
+// Time Complexity: O(s)
+func (ss *ShardedSet[T]) Size() int {
+	n := 0
+	for _, s := range ss.shards {
+		n += s.Size()
+	}
+	return n
+}
+
+// Clear removes all elements from every shard.
+//
+// Time Complexity: O(s)
+func (ss *ShardedSet[T]) Clear() {
+	for _, s := range ss.shards {
+		s.Clear()
+	}
+}
+
+// Items returns a slice containing all elements of all shards. The order of elements
+// is not guaranteed.
+//
+// Time Complexity: O(n + s)
+func (ss *ShardedSet[T]) Items() []T {
+	var items []T
+	for _, s := range ss.shards {
+		items = append(items, s.Items()...)
+	}
+	return items
+}
+
+// Shards returns the number of shards.
+//
+// Time Complexity: O(1)
+func (ss *ShardedSet[T]) Shards() int {
+	return len(ss.shards)
+}
@@ -0,0 +1,82 @@
+package set
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestShardedSet(t *testing.T) {
+	ss := NewShardedSet[int](4)
+	if ss.Shards() != 4 {
+		t.Errorf("Expected 4 shards, got %d", ss.Shards())
+	}
+	for i := 0; i < 100; i++ {
+		if !ss.Insert(i) {
+			t.Errorf("Insert(%d): expected true", i)
+		}
+	}
+	if ss.Insert(42) {
+		t.Errorf("Insert(42) twice: expected false")
+	}
+	if ss.Size() != 100 || !ss.Contain(99) || ss.Contain(100) {
+		t.Errorf("Expected 0..99, got size %d", ss.Size())
+	}
+
+	// the elements must be spread over more than one shard
+	used := 0
+	for _, s := range ss.shards {
+		if s.Size() > 0 {
+			used++
+		}
+	}
+	if used < 2 {
+		t.Errorf("Expected elements spread across shards, %d used", used)
+	}
+
+	if !ss.Remove(7) || ss.Remove(7) || ss.Contain(7) {
+		t.Errorf("Remove: expected true once")
+	}
+	items := ss.Items()
+	sort.Ints(items)
+	if len(items) != 99 || items[0] != 0 || items[7] != 8 {
+		t.Errorf("Unexpected items %v", items)
+	}
+
+	ss.Clear()
+	if ss.Size() != 0 {
+		t.Errorf("Expected empty set after Clear, got %d", ss.Size())
+	}
+
+	if d := NewShardedSet[string](0); d.Shards() != runtime.GOMAXPROCS(0) {
+		t.Errorf("Expected GOMAXPROCS shards by default, got %d", d.Shards())
+	}
+}
+
+func TestShardedSetConcurrent(t *testing.T) {
+	ss := NewShardedSet[int](8)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	added := 0
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := 0
+			for i := 0; i < 1000; i++ {
+				if ss.Insert(i) {
+					n++
+				}
+			}
+			mu.Lock()
+			added += n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	// every element is added by exactly one goroutine
+	if added != 1000 || ss.Size() != 1000 {
+		t.Errorf("Expected 1000 elements added once, got %d added and size %d", added, ss.Size())
+	}
+}
@@ -5,35 +5,83 @@ An UnorderedSet stores unique elements of any type and supports concurrent acces
 It provides basic set operations such as insertion, removal, containment check, and retrieval of all elements.
 
 Key Features:
-  - Insert: Add elements to the set. Duplicate insertions are ignored.
-  - Remove: Delete elements from the set.
+  - Insert: Add elements to the set. Duplicate insertions are ignored and reported as false.
+  - NewUnorderedSetOf / FromSlice: Build a set from a list of elements.
+  - InsertAll / RemoveAll / RetainAll: Bulk updates under a single lock acquisition.
+  - Remove: Delete elements from the set, reporting whether the element was present.
+  - Pop / Sample: Remove an arbitrary element, or pick random elements without removal.
+  - Update: Run multi-step membership logic atomically under the write lock.
   - Contain: Check if an element exists in the set.
   - Size: Get the number of elements in the set.
   - Clear: Remove all elements from the set.
   - Items: Retrieve all elements in the set as a slice (order not guaranteed).
+  - AppendItems: Append the elements to a reusable buffer instead of allocating.
+  - All: Range-over-func iteration over a snapshot of the elements.
+  - ItemsSorted / String: Deterministic output for logs and golden tests.
+  - Union / Intersect / Difference / SymmetricDifference: Combine two sets into a new one.
+  - IsSubsetOf / IsSupersetOf / IsDisjointFrom / Equal: Compare two sets without copying them.
+  - MultiSet: A bag counting how many times each element was added.
+  - ShardedSet: A hash-sharded variant for write-heavy concurrent workloads.
+  - BitSet: A bitmap of small non-negative integers with word-level Union/Intersect.
+  - HashSet: A set of non-comparable elements using caller-supplied hash and equality.
+  - BloomFilter: A probabilistic, memory-bounded membership filter with a target FPP.
+  - KeySetView / FromKeys: Use the keys of an existing map in set algebra.
+  - OrderedSet: A sorted set backed by a red-black tree, with Min/Max, Floor/Ceiling and
+    Range queries in O(log n).
 
 Concurrency:
   - All operations are safe for concurrent use by multiple goroutines.
 */
 package set
 
-import "sync"
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
 
 // UnorderedSet represents a generic unordered set data structure.
 // It stores unique elements and ensures thread-safe operations.
 type UnorderedSet[T comparable] struct {
 	lockObj sync.RWMutex
-	items   map[T]bool
+	items   map[T]struct{}
 }
 
 // NewUnorderedSet creates and returns a new, empty UnorderedSet.
 //
 // Time Complexity: O(1)
 func NewUnorderedSet[T comparable]() *UnorderedSet[T] {
-	return &UnorderedSet[T]{items: make(map[T]bool)}
+	return &UnorderedSet[T]{items: make(map[T]struct{})}
 }
 
-// Insert adds an element to the set. If an element is added, it returns true otherwise return false
+// NewUnorderedSetOf creates and returns a new UnorderedSet holding items.
+// Duplicates among items are stored once.
+//
+// Time Complexity: O(n), where n = len(items)
+func NewUnorderedSetOf[T comparable](items ...T) *UnorderedSet[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates and returns a new UnorderedSet holding the elements of items.
+// Duplicates among items are stored once; items is not retained.
+//
+// Time Complexity: O(n), where n = len(items)
+func FromSlice[T comparable](items []T) *UnorderedSet[T] {
+	us := &UnorderedSet[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		us.items[item] = struct{}{}
+	}
+	return us
+}
+
+// Insert adds an element to the set.
+// Returns true if the element was newly added, false if it was already present.
 // Algorithm: Map insertion ensures uniqueness. Lock acquired for thread-safety.
 //
 // Time Complexity: O(1) amortized
@@ -41,13 +89,14 @@ func (us *UnorderedSet[T]) Insert(item T) bool {
 	us.lockObj.Lock()
 	defer us.lockObj.Unlock()
 	if _, exist := us.items[item]; !exist {
-		us.items[item] = true
+		us.items[item] = struct{}{}
 		return true
 	}
 	return false
 }
 
 // Remove deletes an element from the set.
+// Returns true if the element was present and removed, false otherwise.
 // Algorithm: Map deletion removes the key if present. Lock acquired for thread-safety.
 //
 // Time Complexity: O(1)
@@ -61,6 +110,130 @@ func (us *UnorderedSet[T]) Remove(item T) bool {
 	return true
 }
 
+// InsertAll adds every element of items to the set and returns how many of them
+// were not in the set before.
+// Algorithm: Map insertion for each element under one write lock acquisition.
+//
+// Time Complexity: O(k) amortized, where k = len(items)
+func (us *UnorderedSet[T]) InsertAll(items ...T) int {
+	us.lockObj.Lock()
+	defer us.lockObj.Unlock()
+	added := 0
+	for _, item := range items {
+		if _, ok := us.items[item]; !ok {
+			us.items[item] = struct{}{}
+			added++
+		}
+	}
+	return added
+}
+
+// RemoveAll deletes every element of items from the set and returns how many of them
+// were in the set.
+// Algorithm: Map deletion for each element under one write lock acquisition.
+//
+// Time Complexity: O(k), where k = len(items)
+func (us *UnorderedSet[T]) RemoveAll(items ...T) int {
+	us.lockObj.Lock()
+	defer us.lockObj.Unlock()
+	removed := 0
+	for _, item := range items {
+		if _, ok := us.items[item]; ok {
+			delete(us.items, item)
+			removed++
+		}
+	}
+	return removed
+}
+
+// RetainAll deletes every element of the set that is not in other, keeping only the
+// intersection, and returns how many elements were deleted. other is not modified.
+// Algorithm: Walk the set and delete the elements missing from other. The set is
+// write-locked and other read-locked.
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (us *UnorderedSet[T]) RetainAll(other *UnorderedSet[T]) int {
+	if us == other {
+		return 0
+	}
+	unlock := lockPair(&us.lockObj, &other.lockObj, true)
+	defer unlock()
+	removed := 0
+	for item := range us.items {
+		if _, ok := other.items[item]; !ok {
+			delete(us.items, item)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Pop removes and returns an arbitrary element of the set, with no particular order
+// or fairness guarantee. Returns false if the set is empty.
+// Algorithm: Take the first key of the map iteration and delete it. Lock acquired for writing.
+//
+// Time Complexity: O(1) average
+func (us *UnorderedSet[T]) Pop() (T, bool) {
+	us.lockObj.Lock()
+	defer us.lockObj.Unlock()
+	for item := range us.items {
+		delete(us.items, item)
+		return item, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Sample returns n distinct elements of the set chosen uniformly at random, without
+// removing them. If n is at least the size of the set, every element is returned;
+// if n <= 0, the result is empty. The order of the result is random too.
+// Algorithm: Reservoir sampling over the map keys. Lock acquired for reading.
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (us *UnorderedSet[T]) Sample(n int) []T {
+	us.lockObj.RLock()
+	defer us.lockObj.RUnlock()
+	if n <= 0 {
+		return nil
+	}
+	sample := make([]T, 0, min(n, len(us.items)))
+	seen := 0
+	for item := range us.items {
+		if len(sample) < n {
+			sample = append(sample, item)
+		} else if j := rand.IntN(seen + 1); j < n {
+			sample[j] = item
+		}
+		seen++
+	}
+	rand.Shuffle(len(sample), func(i, j int) { sample[i], sample[j] = sample[j], sample[i] })
+	return sample
+}
+
+// Update calls fn with the set's underlying map while holding the write lock, so a
+// multi-step read-modify-write such as check-then-insert-then-count is atomic with
+// respect to every other operation on the set.
+//
+// fn may read, add and delete keys of items freely. It must not keep the map after it
+// returns, and must not call methods of the set, which would deadlock.
+//
+// Example:
+//
+//	var admitted bool
+//	s.Update(func(items map[string]struct{}) {
+//	    if _, ok := items[user]; !ok && len(items) < limit {
+//	        items[user] = struct{}{}
+//	        admitted = true
+//	    }
+//	})
+//
+// Time Complexity: O(1) plus the cost of fn
+func (us *UnorderedSet[T]) Update(fn func(items map[T]struct{})) {
+	us.lockObj.Lock()
+	defer us.lockObj.Unlock()
+	fn(us.items)
+}
+
 // Contain checks if an element exists in the set.
 // Returns true if present, false otherwise.
 // Algorithm: Map lookup. Lock acquired for reading.
@@ -90,43 +263,290 @@ func (us *UnorderedSet[T]) Size() int {
 func (us *UnorderedSet[T]) Clear() {
 	us.lockObj.Lock()
 	defer us.lockObj.Unlock()
-	us.items = make(map[T]bool)
+	us.items = make(map[T]struct{})
 }
 
 // Items return a slice containing all elements in the set.
 // The order of elements is not guaranteed.
-// Algorithm: Iterate over the map keys and append to a slice. Lock acquired for writing.
+// Algorithm: Iterate over the map keys and append to a slice. Lock acquired for reading.
 //
 // Time Complexity: O(n), where n = number of elements in the set
 func (us *UnorderedSet[T]) Items() []T {
-	us.lockObj.Lock()
-	defer us.lockObj.Unlock()
-	elements := make([]T, 0, len(us.items))
+	return us.AppendItems([]T{})
+}
+
+// AppendItems appends all elements in the set to buf and returns the extended slice,
+// like append. Passing a buffer reused across calls, e.g. buf[:0], avoids allocating
+// a new slice each time once its capacity suffices.
+// The order of elements is not guaranteed.
+//
+// Example:
+//
+//	var buf []int
+//	for range ticks {
+//	    buf = s.AppendItems(buf[:0])
+//	    process(buf)
+//	}
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (us *UnorderedSet[T]) AppendItems(buf []T) []T {
+	us.lockObj.RLock()
+	defer us.lockObj.RUnlock()
+	buf = slices.Grow(buf, len(us.items))
 	for element := range us.items {
-		elements = append(elements, element)
+		buf = append(buf, element)
+	}
+	return buf
+}
+
+// ItemsSorted returns a slice containing all elements in the set, sorted by less.
+// Unlike Items, the order is deterministic as long as less is a strict ordering.
+//
+// Time Complexity: O(n log n), where n = number of elements in the set
+func (us *UnorderedSet[T]) ItemsSorted(less func(a, b T) bool) []T {
+	items := us.Items()
+	sort.Slice(items, func(i, j int) bool { return less(items[i], items[j]) })
+	return items
+}
+
+// String returns a string representation of the set, implementing fmt.Stringer.
+// The elements are formatted with fmt.Sprint and listed in ascending order of their
+// formatted text, so equal sets always print the same way.
+//
+// Example output:
+//
+//	{apple, banana, cherry}
+//
+// Time Complexity: O(n log n), where n = number of elements in the set
+func (us *UnorderedSet[T]) String() string {
+	us.lockObj.RLock()
+	strs := make([]string, 0, len(us.items))
+	for item := range us.items {
+		strs = append(strs, fmt.Sprint(item))
+	}
+	us.lockObj.RUnlock()
+	slices.Sort(strs)
+	return "{" + strings.Join(strs, ", ") + "}"
+}
+
+// All returns an iterator over a snapshot of the set elements, for use with
+// range-over-func. The order of elements is not guaranteed.
+//
+// The snapshot is taken when the loop starts and no lock is held while the loop body
+// runs, so the body may modify the set; such changes are not seen by the loop.
+// Breaking out of the loop early leaves nothing behind.
+//
+// Example:
+//
+//	for item := range s.All() {
+//	    fmt.Println(item)
+//	}
+//
+// Time Complexity: O(n), where n = number of elements in the set
+func (us *UnorderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		us.lockObj.RLock()
+		items := slices.Collect(maps.Keys(us.items))
+		us.lockObj.RUnlock()
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
 	}
-	return elements
 }
 
 // Iter returns a channel that streams elements of the set.
 // It captures a snapshot at the time of the call, so later modifications
 // to the set will not affect the iteration.
+//
+// The channel is buffered with the whole snapshot and already closed, so no goroutine
+// is involved and a consumer may stop reading at any time. All avoids the channel
+// and is preferred in new code.
+//
+// Time Complexity: O(n), where n = number of elements in the set
 func (us *UnorderedSet[T]) Iter() <-chan T {
-	ch := make(chan T)
+	us.lockObj.RLock()
+	defer us.lockObj.RUnlock()
+	ch := make(chan T, len(us.items))
+	for item := range us.items {
+		ch <- item
+	}
+	close(ch)
+	return ch
+}
 
-	go func() {
-		us.lockObj.RLock()
-		items := make([]T, 0, len(us.items))
-		for item := range us.items {
-			items = append(items, item)
+// rlockPair acquires the read locks a and b and returns a function releasing them.
+// A lock passed as both arguments is acquired once.
+func rlockPair(a, b *sync.RWMutex) (unlock func()) {
+	if a == b {
+		a.RLock()
+		return a.RUnlock
+	}
+	return lockPair(a, b, false)
+}
+
+// lockPair acquires a, for writing if write is set and for reading otherwise, and the
+// read lock b, and returns a function releasing them. a and b must be different locks.
+//
+// The locks are always taken in address order, so concurrent calls such as
+// a.Union(b) and b.RetainAll(a) cannot deadlock.
+func lockPair(a, b *sync.RWMutex, write bool) (unlock func()) {
+	lockA, unlockA := a.RLock, a.RUnlock
+	if write {
+		lockA, unlockA = a.Lock, a.Unlock
+	}
+	if uintptr(unsafe.Pointer(a)) < uintptr(unsafe.Pointer(b)) {
+		lockA()
+		b.RLock()
+	} else {
+		b.RLock()
+		lockA()
+	}
+	return func() {
+		unlockA()
+		b.RUnlock()
+	}
+}
+
+// Union returns a new set holding the elements that are in us, in other, or in both.
+// Neither set is modified.
+// Algorithm: Copy us, then add every element of other. Both sets are read-locked.
+//
+// Time Complexity: O(n + m), where n and m are the sizes of the two sets
+func (us *UnorderedSet[T]) Union(other *UnorderedSet[T]) *UnorderedSet[T] {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	result := &UnorderedSet[T]{items: make(map[T]struct{}, max(len(us.items), len(other.items)))}
+	maps.Copy(result.items, us.items)
+	maps.Copy(result.items, other.items)
+	return result
+}
+
+// Intersect returns a new set holding the elements that are in both us and other.
+// Neither set is modified.
+// Algorithm: Walk the smaller set and keep the elements found in the larger one.
+// Both sets are read-locked.
+//
+// Time Complexity: O(min(n, m))
+func (us *UnorderedSet[T]) Intersect(other *UnorderedSet[T]) *UnorderedSet[T] {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	small, large := us.items, other.items
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	result := NewUnorderedSet[T]()
+	for item := range small {
+		if _, ok := large[item]; ok {
+			result.items[item] = struct{}{}
 		}
-		us.lockObj.RUnlock()
+	}
+	return result
+}
 
-		for _, item := range items {
-			ch <- item
+// Difference returns a new set holding the elements of us that are not in other.
+// Neither set is modified.
+// Algorithm: Walk us and keep the elements missing from other. Both sets are read-locked.
+//
+// Time Complexity: O(n), where n is the size of us
+func (us *UnorderedSet[T]) Difference(other *UnorderedSet[T]) *UnorderedSet[T] {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	result := NewUnorderedSet[T]()
+	for item := range us.items {
+		if _, ok := other.items[item]; !ok {
+			result.items[item] = struct{}{}
 		}
-		close(ch)
-	}()
+	}
+	return result
+}
 
-	return ch
+// SymmetricDifference returns a new set holding the elements that are in exactly one
+// of us and other. Neither set is modified.
+// Algorithm: Walk each set and keep the elements missing from the other one.
+// Both sets are read-locked.
+//
+// Time Complexity: O(n + m)
+func (us *UnorderedSet[T]) SymmetricDifference(other *UnorderedSet[T]) *UnorderedSet[T] {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	result := NewUnorderedSet[T]()
+	for item := range us.items {
+		if _, ok := other.items[item]; !ok {
+			result.items[item] = struct{}{}
+		}
+	}
+	for item := range other.items {
+		if _, ok := us.items[item]; !ok {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// isSubset reports whether every element of a is in b.
+// The caller must hold the read locks of both sets.
+func isSubset[T comparable](a, b map[T]struct{}) bool {
+	if len(a) > len(b) {
+		return false
+	}
+	for item := range a {
+		if _, ok := b[item]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSubsetOf reports whether every element of us is also in other.
+// An empty set is a subset of every set.
+// Algorithm: Look up every element of us in other. Both sets are read-locked.
+//
+// Time Complexity: O(n), where n is the size of us
+func (us *UnorderedSet[T]) IsSubsetOf(other *UnorderedSet[T]) bool {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	return isSubset(us.items, other.items)
+}
+
+// IsSupersetOf reports whether every element of other is also in us.
+// Algorithm: Look up every element of other in us. Both sets are read-locked.
+//
+// Time Complexity: O(m), where m is the size of other
+func (us *UnorderedSet[T]) IsSupersetOf(other *UnorderedSet[T]) bool {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	return isSubset(other.items, us.items)
+}
+
+// IsDisjointFrom reports whether us and other have no element in common.
+// Algorithm: Look up every element of the smaller set in the larger one.
+// Both sets are read-locked.
+//
+// Time Complexity: O(min(n, m))
+func (us *UnorderedSet[T]) IsDisjointFrom(other *UnorderedSet[T]) bool {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	small, large := us.items, other.items
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for item := range small {
+		if _, ok := large[item]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether us and other hold exactly the same elements.
+// Algorithm: Compare the sizes, then look up every element of us in other.
+// Both sets are read-locked.
+//
+// Time Complexity: O(n)
+func (us *UnorderedSet[T]) Equal(other *UnorderedSet[T]) bool {
+	unlock := rlockPair(&us.lockObj, &other.lockObj)
+	defer unlock()
+	return len(us.items) == len(other.items) && isSubset(us.items, other.items)
 }
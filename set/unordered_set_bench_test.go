@@ -52,6 +52,20 @@ func BenchmarkUnorderedSet_Items(b *testing.B) {
 	}
 }
 
+func BenchmarkUnorderedSet_AppendItems(b *testing.B) {
+	set := NewUnorderedSet[int]()
+	for i := 0; i < 100000; i++ {
+		_ = set.Insert(i)
+	}
+	var buf []int
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf = set.AppendItems(buf[:0])
+	}
+}
+
 func BenchmarkUnorderedSet_StringKeys(b *testing.B) {
 	set := NewUnorderedSet[string]()
 	b.ReportAllocs()
@@ -66,3 +80,34 @@ func BenchmarkUnorderedSet_StringKeys(b *testing.B) {
 //BenchmarkUnorderedSet_Remove-12                 26081410               455.4 ns/op             0 B/op          0 allocs/op
 //BenchmarkUnorderedSet_Items-12                      1653           3092915 ns/op          802818 B/op          1 allocs/op
 //BenchmarkUnorderedSet_StringKeys-12              6781633               746.9 ns/op            74 B/op          1 allocs/op
+
+// Parallel inserts on a single UnorderedSet against a ShardedSet.
+func BenchmarkShardedSet_InsertParallel(b *testing.B) {
+	b.Run("UnorderedSet", func(b *testing.B) {
+		set := NewUnorderedSet[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				_ = set.Insert(i)
+			}
+		})
+	})
+	b.Run("ShardedSet", func(b *testing.B) {
+		set := NewShardedSet[int](0)
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				_ = set.Insert(i)
+			}
+		})
+	})
+}
+
+// Benchmark Set/Test on a dense BitSet.
+func BenchmarkBitSet_SetTest(b *testing.B) {
+	bs := NewBitSet(1 << 16)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bs.Set(i & 0xffff)
+		_ = bs.Test((i + 1) & 0xffff)
+	}
+}
@@ -1,11 +1,21 @@
 package set
 
 import (
+	"fmt"
 	"reflect"
+	"runtime"
 	"sort"
+	"sync"
 	"testing"
 )
 
+// sortedItems returns the elements of s in ascending order.
+func sortedItems(s *UnorderedSet[int]) []int {
+	items := s.Items()
+	sort.Ints(items)
+	return items
+}
+
 func TestUnorderedSet_Clear(t *testing.T) {
 	set := NewUnorderedSet[string]()
 
@@ -27,7 +37,10 @@ func TestUnorderedSet_Clear(t *testing.T) {
 
 func TestUnorderedSet_Insert(t *testing.T) {
 	set := NewUnorderedSet[string]()
-	_ = set.Insert("How")
+	ok := set.Insert("How")
+	if !ok {
+		t.Errorf("Expected true got %v\n", ok)
+	}
 	_ = set.Insert("Are")
 	_ = set.Insert("How")
 	_ = set.Insert("You")
@@ -96,6 +109,10 @@ func TestUnorderedSet_Remove(t *testing.T) {
 	if notOk {
 		t.Errorf("Expected false, Got %v\n", notOk)
 	}
+	notOk = set.Remove("banana")
+	if notOk {
+		t.Errorf("Expected false for a second removal, Got %v\n", notOk)
+	}
 	if set.Size() != 2 {
 		t.Errorf("Unexpected set size. Expected: %d, Got: %d", 2, set.Size())
 	}
@@ -121,3 +138,307 @@ func TestUnorderedSet_Iter(t *testing.T) {
 		t.Errorf("Expected %v, Got %v\n", authors, actual)
 	}
 }
+
+func TestUnorderedSet_Algebra(t *testing.T) {
+	a := NewUnorderedSetOf(1, 2, 3, 4)
+	b := NewUnorderedSetOf(3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  *UnorderedSet[int]
+		want []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"Intersect", a.Intersect(b), []int{3, 4}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"Difference reversed", b.Difference(a), []int{5}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 5}},
+		{"Union with self", a.Union(a), []int{1, 2, 3, 4}},
+		{"Difference with self", a.Difference(a), []int{}},
+		{"Intersect with empty", a.Intersect(NewUnorderedSet[int]()), []int{}},
+	}
+	for _, tt := range tests {
+		if got := sortedItems(tt.got); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	// the operands are left untouched and the results are independent sets
+	u := a.Union(b)
+	u.Insert(100)
+	if got := sortedItems(a); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected a unchanged, got %v", got)
+	}
+	if got := sortedItems(b); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		t.Errorf("Expected b unchanged, got %v", got)
+	}
+}
+
+func TestUnorderedSet_AlgebraConcurrent(t *testing.T) {
+	a := NewUnorderedSetOf(1, 2, 3)
+	b := NewUnorderedSetOf(2, 3, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				// opposite operand orders and concurrent writers must not deadlock
+				if i%2 == 0 {
+					a.Union(b)
+					b.RetainAll(a)
+					b.Insert(j)
+				} else {
+					b.Intersect(a)
+					a.RetainAll(b)
+					a.Insert(j)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestUnorderedSet_Relations(t *testing.T) {
+	a := NewUnorderedSetOf(1, 2, 3)
+	sub := NewUnorderedSetOf(1, 2)
+	other := NewUnorderedSetOf(4, 5)
+	empty := NewUnorderedSet[int]()
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"sub ⊆ a", sub.IsSubsetOf(a), true},
+		{"a ⊆ sub", a.IsSubsetOf(sub), false},
+		{"a ⊆ a", a.IsSubsetOf(a), true},
+		{"empty ⊆ a", empty.IsSubsetOf(a), true},
+		{"a ⊇ sub", a.IsSupersetOf(sub), true},
+		{"sub ⊇ a", sub.IsSupersetOf(a), false},
+		{"a ⊇ empty", a.IsSupersetOf(empty), true},
+		{"a disjoint other", a.IsDisjointFrom(other), true},
+		{"a disjoint sub", a.IsDisjointFrom(sub), false},
+		{"a disjoint a", a.IsDisjointFrom(a), false},
+		{"empty disjoint empty", empty.IsDisjointFrom(empty), true},
+		{"a == a", a.Equal(a), true},
+		{"a == copy of a", a.Equal(NewUnorderedSetOf(3, 2, 1)), true},
+		{"a == sub", a.Equal(sub), false},
+		{"a == same size", a.Equal(NewUnorderedSetOf(1, 2, 4)), false},
+		{"empty == empty", empty.Equal(NewUnorderedSet[int]()), true},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+func TestUnorderedSet_Constructors(t *testing.T) {
+	if got := sortedItems(NewUnorderedSetOf(3, 1, 2, 1)); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("NewUnorderedSetOf: expected [1 2 3], got %v", got)
+	}
+	if s := NewUnorderedSetOf[int](); s.Size() != 0 {
+		t.Errorf("NewUnorderedSetOf(): expected empty set, got %v", s.Items())
+	}
+
+	items := []int{5, 5, 4}
+	s := FromSlice(items)
+	items[0] = 9
+	if got := sortedItems(s); !reflect.DeepEqual(got, []int{4, 5}) {
+		t.Errorf("FromSlice: expected [4 5], got %v", got)
+	}
+	if !s.Insert(6) {
+		t.Errorf("Expected set from FromSlice to accept inserts")
+	}
+}
+
+func TestUnorderedSet_BulkOperations(t *testing.T) {
+	s := NewUnorderedSetOf(1, 2)
+	if added := s.InsertAll(2, 3, 4, 4); added != 2 {
+		t.Errorf("InsertAll: expected 2 added, got %d", added)
+	}
+	if removed := s.RemoveAll(1, 4, 9); removed != 2 {
+		t.Errorf("RemoveAll: expected 2 removed, got %d", removed)
+	}
+	if got := sortedItems(s); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("Expected [2 3], got %v", got)
+	}
+
+	s.InsertAll(5, 6, 7)
+	keep := NewUnorderedSetOf(3, 6, 8)
+	if removed := s.RetainAll(keep); removed != 3 {
+		t.Errorf("RetainAll: expected 3 removed, got %d", removed)
+	}
+	if got := sortedItems(s); !reflect.DeepEqual(got, []int{3, 6}) {
+		t.Errorf("RetainAll: expected [3 6], got %v", got)
+	}
+	if keep.Size() != 3 {
+		t.Errorf("RetainAll: expected other unchanged, got %v", keep.Items())
+	}
+	if removed := s.RetainAll(s); removed != 0 || s.Size() != 2 {
+		t.Errorf("RetainAll(self): expected no change, got %d removed", removed)
+	}
+}
+
+func TestUnorderedSet_Pop(t *testing.T) {
+	s := NewUnorderedSetOf(1, 2, 3)
+	var popped []int
+	for {
+		v, ok := s.Pop()
+		if !ok {
+			break
+		}
+		popped = append(popped, v)
+	}
+	sort.Ints(popped)
+	if !reflect.DeepEqual(popped, []int{1, 2, 3}) || s.Size() != 0 {
+		t.Errorf("Expected to pop [1 2 3], got %v with %d left", popped, s.Size())
+	}
+	if v, ok := s.Pop(); ok || v != 0 {
+		t.Errorf("Expected (0, false) on empty set, got (%v, %v)", v, ok)
+	}
+}
+
+func TestUnorderedSet_Sample(t *testing.T) {
+	s := NewUnorderedSet[int]()
+	for i := 0; i < 10; i++ {
+		s.Insert(i)
+	}
+	if got := s.Sample(0); len(got) != 0 {
+		t.Errorf("Sample(0): expected empty, got %v", got)
+	}
+	if got := s.Sample(-1); len(got) != 0 {
+		t.Errorf("Sample(-1): expected empty, got %v", got)
+	}
+	all := s.Sample(20)
+	sort.Ints(all)
+	if !reflect.DeepEqual(all, sortedItems(s)) {
+		t.Errorf("Sample(20): expected every element, got %v", all)
+	}
+
+	// every element should be drawn about 3000 times out of 10000 samples of 3
+	counts := make(map[int]int)
+	for i := 0; i < 10000; i++ {
+		got := s.Sample(3)
+		if len(got) != 3 || !NewUnorderedSetOf(got...).IsSubsetOf(s) || NewUnorderedSetOf(got...).Size() != 3 {
+			t.Fatalf("Sample(3): expected 3 distinct members, got %v", got)
+		}
+		for _, v := range got {
+			counts[v]++
+		}
+	}
+	for v, c := range counts {
+		if c < 2500 || c > 3500 {
+			t.Errorf("Sample(3): element %d drawn %d times; expected about 3000", v, c)
+		}
+	}
+	if s.Size() != 10 {
+		t.Errorf("Expected Sample not to remove elements, got size %d", s.Size())
+	}
+}
+
+func TestUnorderedSet_All(t *testing.T) {
+	s := NewUnorderedSetOf(1, 2, 3, 4)
+	var got []int
+	for v := range s.All() {
+		got = append(got, v)
+		s.Insert(v + 10) // the snapshot is not affected
+	}
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+
+	n := 0
+	for range s.All() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("Expected early exit after 1 element, got %d", n)
+	}
+}
+
+func TestUnorderedSet_IterEarlyExitNoLeak(t *testing.T) {
+	s := NewUnorderedSetOf(1, 2, 3, 4, 5)
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		for range s.Iter() {
+			break
+		}
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("Expected no leaked goroutines, had %d, now %d", before, after)
+	}
+}
+
+func TestUnorderedSet_ItemsSortedAndString(t *testing.T) {
+	s := NewUnorderedSetOf(10, 2, 33, 4)
+	if got := s.ItemsSorted(func(a, b int) bool { return a > b }); !reflect.DeepEqual(got, []int{33, 10, 4, 2}) {
+		t.Errorf("ItemsSorted descending = %v; want [33 10 4 2]", got)
+	}
+	// String orders by the formatted text, not by value
+	if got := s.String(); got != "{10, 2, 33, 4}" {
+		t.Errorf("String() = %q; want %q", got, "{10, 2, 33, 4}")
+	}
+	if got := fmt.Sprint(NewUnorderedSetOf("b", "a")); got != "{a, b}" {
+		t.Errorf("fmt.Sprint = %q; want %q", got, "{a, b}")
+	}
+	if got := NewUnorderedSet[int]().String(); got != "{}" {
+		t.Errorf("String() of empty set = %q; want {}", got)
+	}
+}
+
+func TestUnorderedSet_Update(t *testing.T) {
+	s := NewUnorderedSet[int]()
+	const limit = 10
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	admitted := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// the size check and the insert must happen atomically
+			s.Update(func(items map[int]struct{}) {
+				if _, ok := items[i]; !ok && len(items) < limit {
+					items[i] = struct{}{}
+					mu.Lock()
+					admitted++
+					mu.Unlock()
+				}
+			})
+		}(i)
+	}
+	wg.Wait()
+	if admitted != limit || s.Size() != limit {
+		t.Errorf("Expected %d admitted, got %d with size %d", limit, admitted, s.Size())
+	}
+
+	s.Update(func(items map[int]struct{}) {
+		for k := range items {
+			delete(items, k)
+		}
+	})
+	if s.Size() != 0 {
+		t.Errorf("Expected Update to delete every element, got %d left", s.Size())
+	}
+}
+
+func TestUnorderedSet_AppendItems(t *testing.T) {
+	s := NewUnorderedSetOf(3, 1, 2)
+	buf := make([]int, 1, 16)
+	buf[0] = 100
+	got := s.AppendItems(buf)
+	sort.Ints(got[1:])
+	if !reflect.DeepEqual(got, []int{100, 1, 2, 3}) {
+		t.Errorf("AppendItems = %v; want [100 1 2 3]", got)
+	}
+	if &got[0] != &buf[0] {
+		t.Errorf("Expected AppendItems to reuse a buffer with enough capacity")
+	}
+	if got := s.AppendItems(nil); len(got) != 3 {
+		t.Errorf("AppendItems(nil) = %v; want 3 elements", got)
+	}
+}
@@ -0,0 +1,382 @@
+/*
+Package skiplist provides a generic, thread-safe skip list in Go: a sorted map built from
+layers of linked lists, where every layer skips over a random subset of the one below.
+
+A skip list offers the same expected O(log n) lookups and updates as a balanced search
+tree, but its updates only relink a few neighbours instead of rotating subtrees, which
+keeps the code simple and makes it a common base for concurrent sorted maps. Every link
+also records how many elements it skips, so the list answers rank queries in O(log n).
+
+Key Features:
+  - Put / Get / Remove / Contains: Sorted map operations.
+  - Range / All: Range-over-func iteration in key order.
+  - Rank / At: Position of a key and key at a position.
+  - Min / Max / Floor / Ceiling: Order queries.
+  - Implements orderedmap.OrderedMap.
+
+Example:
+
+	sl := skiplist.New[int, string]()
+	sl.Put(30, "c")
+	sl.Put(10, "a")
+	sl.Put(20, "b")
+	sl.Get(20)  // "b", true
+	sl.Rank(25) // 2
+	for k, v := range sl.Range(10, 30) {
+	    fmt.Println(k, v) // 10 a, 20 b
+	}
+*/
+package skiplist
+
+import (
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/Zubayear/ryushin/orderedmap"
+	"golang.org/x/exp/constraints"
+)
+
+var _ orderedmap.OrderedMap[int, int] = (*SkipList[int, int])(nil)
+
+// maxLevel bounds the height of a node; with p = 1/4 it comfortably covers 2^64 keys.
+const maxLevel = 32
+
+// node is an element of the skip list. next[i] is the following node on level i and
+// span[i] the number of level-0 steps that link covers.
+type node[K constraints.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	span  []int
+}
+
+// SkipList is a generic sorted map backed by an indexable skip list. It is safe for
+// concurrent use. The zero value is not usable; create lists with New.
+type SkipList[K constraints.Ordered, V any] struct {
+	lockObj sync.RWMutex
+	head    *node[K, V]
+	level   int
+	size    int
+}
+
+// New creates and returns a new, empty SkipList.
+//
+// Time Complexity: O(1)
+func New[K constraints.Ordered, V any]() *SkipList[K, V] {
+	return &SkipList[K, V]{head: newHead[K, V](), level: 1}
+}
+
+// newHead returns a sentinel node with links on every level.
+func newHead[K constraints.Ordered, V any]() *node[K, V] {
+	return &node[K, V]{next: make([]*node[K, V], maxLevel), span: make([]int, maxLevel)}
+}
+
+// randomLevel returns a node height, choosing each extra level with probability 1/4.
+func randomLevel() int {
+	// every pair of zero bits promotes the node one level
+	lvl := 1 + bits.TrailingZeros64(rand.Uint64())/2
+	return min(lvl, maxLevel)
+}
+
+// Put associates value with key. Returns true if key was newly added, false if an
+// existing value was replaced.
+// Algorithm: Walk down the levels recording the last node before key and its rank on
+// every level, then link a node of random height after them and fix the spans.
+// Lock acquired for writing.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Put(key K, value V) bool {
+	sl.lockObj.Lock()
+	defer sl.lockObj.Unlock()
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		if i < sl.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && x.next[i].key < key {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if x = x.next[0]; x != nil && x.key == key {
+		x.value = value
+		return false
+	}
+
+	lvl := randomLevel()
+	if lvl > sl.level {
+		for i := sl.level; i < lvl; i++ {
+			rank[i] = 0
+			update[i] = sl.head
+			update[i].span[i] = sl.size
+		}
+		sl.level = lvl
+	}
+	x = &node[K, V]{key: key, value: value, next: make([]*node[K, V], lvl), span: make([]int, lvl)}
+	for i := 0; i < lvl; i++ {
+		x.next[i] = update[i].next[i]
+		update[i].next[i] = x
+		x.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := lvl; i < sl.level; i++ {
+		update[i].span[i]++
+	}
+	sl.size++
+	return true
+}
+
+// Get returns the value associated with key. Returns false if key is not present.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Get(key K) (V, bool) {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	if x := sl.ceiling(key); x != nil && x.key == key {
+		return x.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is present.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	_, ok := sl.Get(key)
+	return ok
+}
+
+// Remove deletes key and its value. Returns true if key was present, false otherwise.
+// Algorithm: Walk down the levels as in Put, unlink the node wherever it is linked and
+// shorten the spans that jumped over it. Lock acquired for writing.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Remove(key K) bool {
+	sl.lockObj.Lock()
+	defer sl.lockObj.Unlock()
+	var update [maxLevel]*node[K, V]
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	x = x.next[0]
+	if x == nil || x.key != key {
+		return false
+	}
+	for i := 0; i < sl.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.size--
+	return true
+}
+
+// Len returns the number of keys in the list.
+//
+// Time Complexity: O(1)
+func (sl *SkipList[K, V]) Len() int {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	return sl.size
+}
+
+// Clear removes all keys from the list.
+//
+// Time Complexity: O(1)
+func (sl *SkipList[K, V]) Clear() {
+	sl.lockObj.Lock()
+	defer sl.lockObj.Unlock()
+	sl.head = newHead[K, V]()
+	sl.level = 1
+	sl.size = 0
+}
+
+// Min returns the smallest key and its value. Returns false if the list is empty.
+//
+// Time Complexity: O(1)
+func (sl *SkipList[K, V]) Min() (K, V, bool) {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	if x := sl.head.next[0]; x != nil {
+		return x.key, x.value, true
+	}
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+// Max returns the largest key and its value. Returns false if the list is empty.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Max() (K, V, bool) {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == sl.head {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return x.key, x.value, true
+}
+
+// Floor returns the largest key less than or equal to key, and its value.
+// Returns false if every key is greater than key.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key <= key {
+			x = x.next[i]
+		}
+	}
+	if x == sl.head {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return x.key, x.value, true
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value.
+// Returns false if every key is less than key.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	if x := sl.ceiling(key); x != nil {
+		return x.key, x.value, true
+	}
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+// Rank returns the number of keys less than key, which is the index key has, or would
+// have, in sorted order.
+// Algorithm: Sum the spans of the links followed while searching for key.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) Rank(key K) int {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	rank := 0
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return rank
+}
+
+// At returns the key and value at index i in sorted order, so At(0) is the minimum.
+// Returns false if i is out of range.
+// Algorithm: Follow the longest links whose spans do not overshoot i + 1 steps.
+//
+// Time Complexity: O(log n) expected
+func (sl *SkipList[K, V]) At(i int) (K, V, bool) {
+	sl.lockObj.RLock()
+	defer sl.lockObj.RUnlock()
+	if i < 0 || i >= sl.size {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	traversed := 0
+	x := sl.head
+	for lvl := sl.level - 1; lvl >= 0; lvl-- {
+		for x.next[lvl] != nil && traversed+x.span[lvl] <= i+1 {
+			traversed += x.span[lvl]
+			x = x.next[lvl]
+		}
+	}
+	return x.key, x.value, true
+}
+
+// All returns an iterator over a snapshot of the pairs in ascending key order. No lock
+// is held while the loop body runs, so the body may modify the list.
+//
+// Time Complexity: O(n), where n = number of keys in the list
+func (sl *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		sl.lockObj.RLock()
+		snapshot := sl.collect(sl.head.next[0], nil)
+		sl.lockObj.RUnlock()
+		for _, e := range snapshot {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range is like All, restricted to the keys with from <= key < to.
+//
+// Time Complexity: O(log n + k) expected, where k = number of pairs in the range
+func (sl *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		sl.lockObj.RLock()
+		snapshot := sl.collect(sl.ceiling(from), &to)
+		sl.lockObj.RUnlock()
+		for _, e := range snapshot {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// entry is a key-value pair copied out of the list.
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// collect copies the pairs from x onwards, stopping before *to when it is not nil.
+// The caller must hold the lock.
+func (sl *SkipList[K, V]) collect(x *node[K, V], to *K) []entry[K, V] {
+	var entries []entry[K, V]
+	for ; x != nil && (to == nil || x.key < *to); x = x.next[0] {
+		entries = append(entries, entry[K, V]{x.key, x.value})
+	}
+	return entries
+}
+
+// ceiling returns the first node whose key is not less than key, or nil.
+// The caller must hold the lock.
+func (sl *SkipList[K, V]) ceiling(key K) *node[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
@@ -0,0 +1,21 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// Put and Get are benchmarked against the other OrderedMap implementations in the
+// orderedmap package; Rank is specific to SkipList.
+func BenchmarkSkipList_Rank(b *testing.B) {
+	keys := rand.New(rand.NewPCG(1, 1)).Perm(1 << 16)
+	sl := New[int, int]()
+	for _, k := range keys {
+		sl.Put(k, k)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sl.Rank(keys[i%len(keys)])
+	}
+}
@@ -0,0 +1,193 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// checkSpans verifies that the links on every level are sorted and that their spans
+// add up to the level-0 distances.
+func checkSpans[V any](t *testing.T, sl *SkipList[int, V]) {
+	t.Helper()
+	pos := map[*node[int, V]]int{sl.head: 0}
+	i := 0
+	for x := sl.head.next[0]; x != nil; x = x.next[0] {
+		i++
+		pos[x] = i
+	}
+	if i != sl.size {
+		t.Fatalf("level 0 has %d nodes, size is %d", i, sl.size)
+	}
+	for lvl := 0; lvl < sl.level; lvl++ {
+		for x := sl.head; x.next[lvl] != nil; x = x.next[lvl] {
+			if x != sl.head && !(x.key < x.next[lvl].key) {
+				t.Fatalf("level %d out of order at %v", lvl, x.key)
+			}
+			if got, want := x.span[lvl], pos[x.next[lvl]]-pos[x]; got != want {
+				t.Fatalf("level %d span after %v = %d; want %d", lvl, x.key, got, want)
+			}
+		}
+	}
+}
+
+func TestSkipListBasic(t *testing.T) {
+	sl := New[int, string]()
+	if _, _, ok := sl.Min(); ok {
+		t.Errorf("Min: expected false on empty list")
+	}
+	if _, _, ok := sl.Max(); ok {
+		t.Errorf("Max: expected false on empty list")
+	}
+	for _, k := range []int{30, 10, 50, 20, 40} {
+		if !sl.Put(k, string(rune('a'+k/10-1))) {
+			t.Errorf("Put(%d): expected true", k)
+		}
+	}
+	if sl.Put(20, "B") {
+		t.Errorf("Put(20) twice: expected false")
+	}
+	if v, ok := sl.Get(20); !ok || v != "B" {
+		t.Errorf("Get(20) = %q, %v; want B", v, ok)
+	}
+	if _, ok := sl.Get(25); ok || sl.Contains(25) || !sl.Contains(50) {
+		t.Errorf("Unexpected lookup result")
+	}
+	if k, v, _ := sl.Min(); k != 10 || v != "a" {
+		t.Errorf("Min = %v, %q; want 10, a", k, v)
+	}
+	if k, v, _ := sl.Max(); k != 50 || v != "e" {
+		t.Errorf("Max = %v, %q; want 50, e", k, v)
+	}
+
+	var keys []int
+	for k := range sl.All() {
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{10, 20, 30, 40, 50}) {
+		t.Errorf("All = %v; want sorted keys", keys)
+	}
+	keys = keys[:0]
+	for k, v := range sl.Range(15, 40) {
+		keys = append(keys, k)
+		if v == "c" {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []int{20, 30}) {
+		t.Errorf("Range(15, 40) with early exit = %v; want [20 30]", keys)
+	}
+	for range sl.Range(40, 40) {
+		t.Errorf("Range(40, 40): expected no elements")
+	}
+
+	if k, _, ok := sl.Floor(35); !ok || k != 30 {
+		t.Errorf("Floor(35) = %v, %v; want 30", k, ok)
+	}
+	if k, _, ok := sl.Ceiling(35); !ok || k != 40 {
+		t.Errorf("Ceiling(35) = %v, %v; want 40", k, ok)
+	}
+	if k, _, ok := sl.Floor(40); !ok || k != 40 {
+		t.Errorf("Floor(40) = %v, %v; want 40", k, ok)
+	}
+	if _, _, ok := sl.Floor(5); ok {
+		t.Errorf("Floor(5): expected false")
+	}
+	if _, _, ok := sl.Ceiling(55); ok {
+		t.Errorf("Ceiling(55): expected false")
+	}
+
+	if sl.Rank(5) != 0 || sl.Rank(10) != 0 || sl.Rank(35) != 3 || sl.Rank(99) != 5 {
+		t.Errorf("Rank: unexpected result")
+	}
+	if k, _, ok := sl.At(3); !ok || k != 40 {
+		t.Errorf("At(3) = %v, %v; want 40", k, ok)
+	}
+	if _, _, ok := sl.At(5); ok {
+		t.Errorf("At(5): expected false")
+	}
+	if _, _, ok := sl.At(-1); ok {
+		t.Errorf("At(-1): expected false")
+	}
+
+	if !sl.Remove(30) || sl.Remove(30) || sl.Len() != 4 {
+		t.Errorf("Remove: expected true once")
+	}
+	sl.Clear()
+	if sl.Len() != 0 || sl.Contains(10) {
+		t.Errorf("Expected empty list after Clear")
+	}
+	sl.Put(1, "x")
+	if k, _, ok := sl.At(0); !ok || k != 1 {
+		t.Errorf("Expected list usable after Clear")
+	}
+}
+
+func TestSkipListRandomizedAgainstMap(t *testing.T) {
+	sl := New[int, int]()
+	ref := make(map[int]int)
+	r := rand.New(rand.NewPCG(3, 4))
+	for i := 0; i < 20000; i++ {
+		k := r.IntN(2000)
+		if r.IntN(3) == 0 {
+			_, had := ref[k]
+			if got := sl.Remove(k); got != had {
+				t.Fatalf("Remove(%d) = %v; want %v", k, got, had)
+			}
+			delete(ref, k)
+		} else {
+			_, had := ref[k]
+			if got := sl.Put(k, i); got == had {
+				t.Fatalf("Put(%d) = %v; want %v", k, got, !had)
+			}
+			ref[k] = i
+		}
+		if i%1000 == 0 {
+			checkSpans(t, sl)
+		}
+	}
+	checkSpans(t, sl)
+
+	keys := make([]int, 0, len(ref))
+	for k := range ref {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	if sl.Len() != len(keys) {
+		t.Fatalf("Len = %d; want %d", sl.Len(), len(keys))
+	}
+	for i, k := range keys {
+		if v, ok := sl.Get(k); !ok || v != ref[k] {
+			t.Fatalf("Get(%d) = %v, %v; want %v", k, v, ok, ref[k])
+		}
+		if got := sl.Rank(k); got != i {
+			t.Fatalf("Rank(%d) = %d; want %d", k, got, i)
+		}
+		if got, _, _ := sl.At(i); got != k {
+			t.Fatalf("At(%d) = %d; want %d", i, got, k)
+		}
+	}
+}
+
+func TestSkipListConcurrent(t *testing.T) {
+	sl := New[int, int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				sl.Put(g*1000+i, i)
+				sl.Get(i)
+				sl.Rank(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if sl.Len() != 4000 {
+		t.Errorf("Expected 4000 keys, got %d", sl.Len())
+	}
+	checkSpans(t, sl)
+}
@@ -0,0 +1,174 @@
+package stack
+
+import (
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// extremumEntry is an element of an extremumStack together with the extremum of the
+// elements from the bottom of the stack up to and including it.
+type extremumEntry[T any] struct {
+	val, extremum T
+}
+
+// extremumStack is a stack that caches, in every entry, the extremum of the entries
+// below it, so the extremum of the whole stack is always found at the top.
+// It is the shared implementation of MinStack and MaxStack.
+//
+// Fields:
+//   - entries: the stack of values with their cached extrema; it is unsynchronized
+//     because mutex guards it
+//   - before: reports whether a is a strictly better extremum than b
+//   - mutex: guards the stack, since Push reads the top before pushing
+type extremumStack[T any] struct {
+	entries *Stack[extremumEntry[T]]
+	before  func(a, b T) bool
+	mutex   sync.Mutex
+}
+
+func newExtremumStack[T any](before func(a, b T) bool) extremumStack[T] {
+	return extremumStack[T]{entries: NewUnsafeStack[extremumEntry[T]](), before: before}
+}
+
+// Push adds an element to the top of the stack.
+//
+// Complexity: Amortized O(1)
+func (s *extremumStack[T]) Push(val T) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e := extremumEntry[T]{val: val, extremum: val}
+	if top, err := s.entries.Peek(); err == nil && !s.before(val, top.extremum) {
+		e.extremum = top.extremum
+	}
+	return s.entries.Push(e)
+}
+
+// Pop removes and returns the top element from the stack.
+// Returns ErrEmpty if the stack is empty.
+//
+// Complexity: O(1)
+func (s *extremumStack[T]) Pop() (T, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e, err := s.entries.Pop()
+	return e.val, err
+}
+
+// Peek returns the element at the top of the stack without removing it.
+// Returns ErrEmpty if the stack is empty.
+//
+// Complexity: O(1)
+func (s *extremumStack[T]) Peek() (T, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e, err := s.entries.Peek()
+	return e.val, err
+}
+
+// Size returns the number of elements currently in the stack.
+//
+// Complexity: O(1)
+func (s *extremumStack[T]) Size() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.entries.Size()
+}
+
+// IsEmpty checks whether the stack has no elements.
+//
+// Complexity: O(1)
+func (s *extremumStack[T]) IsEmpty() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.entries.IsEmpty()
+}
+
+// extremum returns the cached extremum of the top entry.
+//
+// Complexity: O(1)
+func (s *extremumStack[T]) extremum() (T, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e, err := s.entries.Peek()
+	if err != nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return e.extremum, nil
+}
+
+// MinStack is a stack that also reports its smallest element in O(1).
+//
+// Every entry caches the minimum of the entries below it, so Min is a lookup at the
+// top and Pop restores the previous minimum for free. It is safe for concurrent use.
+//
+// Example:
+//
+//	s := stack.NewMinStack[int]()
+//	s.Push(3)
+//	s.Push(1)
+//	s.Push(2)
+//	m, _ := s.Min() // 1
+//	s.Pop()
+//	s.Pop()
+//	m, _ = s.Min() // 3
+type MinStack[T any] struct {
+	extremumStack[T]
+}
+
+// NewMinStack creates and returns an empty MinStack using the natural ordering of T.
+//
+// Complexity: O(1)
+func NewMinStack[T constraints.Ordered]() *MinStack[T] {
+	return NewMinStackWithComparator(func(a, b T) bool { return a < b })
+}
+
+// NewMinStackWithComparator creates and returns an empty MinStack ordered by less.
+//
+// Complexity: O(1)
+func NewMinStackWithComparator[T any](less func(a, b T) bool) *MinStack[T] {
+	return &MinStack[T]{newExtremumStack(less)}
+}
+
+// Min returns the smallest element of the stack. Returns ErrEmpty if the stack is empty.
+//
+// Complexity: O(1)
+func (s *MinStack[T]) Min() (T, error) {
+	return s.extremum()
+}
+
+// MaxStack is a stack that also reports its largest element in O(1).
+//
+// It works like MinStack with the ordering reversed. It is safe for concurrent use.
+//
+// Example:
+//
+//	s := stack.NewMaxStack[int]()
+//	s.Push(1)
+//	s.Push(3)
+//	m, _ := s.Max() // 3
+type MaxStack[T any] struct {
+	extremumStack[T]
+}
+
+// NewMaxStack creates and returns an empty MaxStack using the natural ordering of T.
+//
+// Complexity: O(1)
+func NewMaxStack[T constraints.Ordered]() *MaxStack[T] {
+	return NewMaxStackWithComparator(func(a, b T) bool { return a < b })
+}
+
+// NewMaxStackWithComparator creates and returns an empty MaxStack ordered by less.
+//
+// Complexity: O(1)
+func NewMaxStackWithComparator[T any](less func(a, b T) bool) *MaxStack[T] {
+	return &MaxStack[T]{newExtremumStack(func(a, b T) bool { return less(b, a) })}
+}
+
+// Max returns the largest element of the stack. Returns ErrEmpty if the stack is empty.
+//
+// Complexity: O(1)
+func (s *MaxStack[T]) Max() (T, error) {
+	return s.extremum()
+}
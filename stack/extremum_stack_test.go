@@ -0,0 +1,78 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMinStack(t *testing.T) {
+	s := NewMinStack[int]()
+	if _, err := s.Min(); err == nil {
+		t.Errorf("Expected error on Min for empty stack")
+	}
+
+	vals := []int{5, 3, 7, 3, 8, 1, 9}
+	for i, v := range vals {
+		if ok, err := s.Push(v); !ok || err != nil {
+			t.Fatalf("Push(%d) failed, err=%v", v, err)
+		}
+		if m, _ := s.Min(); m != slices.Min(vals[:i+1]) {
+			t.Errorf("Min after pushing %v = %d", vals[:i+1], m)
+		}
+	}
+	for i := len(vals) - 1; i > 0; i-- {
+		if v, err := s.Pop(); err != nil || v != vals[i] {
+			t.Errorf("Pop expected %d, got %d, err=%v", vals[i], v, err)
+		}
+		if m, _ := s.Min(); m != slices.Min(vals[:i]) {
+			t.Errorf("Min after popping to %v = %d", vals[:i], m)
+		}
+	}
+	if v, _ := s.Peek(); v != 5 || s.Size() != 1 || s.IsEmpty() {
+		t.Errorf("Expected only 5 left, got %d with size %d", v, s.Size())
+	}
+}
+
+func TestMaxStack(t *testing.T) {
+	s := NewMaxStack[string]()
+	for _, v := range []string{"b", "a", "d", "c"} {
+		_, _ = s.Push(v)
+	}
+	if m, _ := s.Max(); m != "d" {
+		t.Errorf("Max expected d, got %s", m)
+	}
+	_, _ = s.Pop()
+	_, _ = s.Pop()
+	if m, _ := s.Max(); m != "b" {
+		t.Errorf("Max expected b, got %s", m)
+	}
+	_, _ = s.Pop()
+	_, _ = s.Pop()
+	if _, err := s.Max(); err == nil || !s.IsEmpty() {
+		t.Errorf("Expected error on Max for empty stack")
+	}
+	if _, err := s.Pop(); err == nil {
+		t.Errorf("Expected error when popping from empty stack")
+	}
+}
+
+func TestExtremumStackWithComparator(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	byPriority := func(a, b task) bool { return a.priority < b.priority }
+	mins := NewMinStackWithComparator(byPriority)
+	maxs := NewMaxStackWithComparator(byPriority)
+	for _, tk := range []task{{"a", 2}, {"b", 5}, {"c", 1}, {"d", 5}} {
+		_, _ = mins.Push(tk)
+		_, _ = maxs.Push(tk)
+	}
+	if m, _ := mins.Min(); m.name != "c" {
+		t.Errorf("Min expected c, got %s", m.name)
+	}
+	// ties keep the element pushed first
+	if m, _ := maxs.Max(); m.name != "b" {
+		t.Errorf("Max expected b, got %s", m.name)
+	}
+}
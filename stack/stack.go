@@ -4,10 +4,19 @@ Package stack provides a generic, concurrency-safe implementation of a stack
 utility methods for stack manipulation.
 
 Features:
-  - Generic Type Support: Works with any comparable type.
-  - Thread-Safety: All operations are protected using sync.RWMutex.
-  - Dynamic Resizing: The underlying slice doubles in capacity when full.
+  - Generic Type Support: Works with any type, including non-comparable ones
+    (structs holding slices, funcs, ...); the stack never compares elements.
+  - Thread-Safety: All operations are protected using sync.RWMutex; NewUnsafeStack
+    skips locking for single-goroutine hot paths.
+  - Dynamic Resizing: The underlying slice doubles in capacity when full, and halves
+    when it falls below a quarter full, never below the initial capacity.
   - Utility Methods: Peek, ValueAt, Clear, Size, IsEmpty, IsFull.
+  - Stats / Cap: Report capacity, high-water mark and push/pop counters (O(1)).
+  - SwapTop / Dup / Rot: Atomic top-of-stack primitives for stack machines.
+  - PushMonotonic: Monotonic stack push for next-greater-element style algorithms.
+  - Mark / ResetTo: Checkpoints of the depth for rolling back nested scopes.
+  - Search / SearchFunc: 1-based depth of an element from the top, like java.util.Stack.
+  - MinStack / MaxStack: Stacks that also report their smallest or largest element in O(1).
 
 Use Cases:
   - Expression evaluation (e.g., postfix, infix).
@@ -28,12 +37,13 @@ Complexity:
   - Pop: O(1)
   - Peek: O(1)
   - ValueAt: O(1)
-  - Clear: O(1)
+  - Clear: O(C), where C is the initial capacity
 
 Implementation Details:
   - Internally uses a slice for storage.
   - `top` tracks the index of the last inserted element.
-  - Capacity grows dynamically when the stack is full.
+  - Capacity grows dynamically when the stack is full and shrinks after large pops;
+    Shrink releases unused capacity on demand.
   - Protected by RWMutex for concurrent access.
 */
 package stack
@@ -43,6 +53,18 @@ import (
 	"sync"
 )
 
+// Errors returned by Stack operations. Callers can test for them with errors.Is.
+var (
+	// ErrEmpty is returned when an element is requested from an empty stack.
+	ErrEmpty = errors.New("stack empty")
+	// ErrInvalidPosition is returned when a position is outside the stack.
+	ErrInvalidPosition = errors.New("invalid position")
+	// ErrNotEnoughElements is returned by SwapTop when the stack holds fewer than two elements.
+	ErrNotEnoughElements = errors.New("not enough elements")
+	// ErrInvalidCheckpoint is returned by ResetTo when the stack is shallower than the checkpoint.
+	ErrInvalidCheckpoint = errors.New("invalid checkpoint")
+)
+
 // Stack represents a generic stack (LIFO) data structure with dynamic resizing.
 // It is safe for concurrent use as sync.RWMutex guards all operations.
 //
@@ -51,7 +73,7 @@ import (
 //
 // Type parameter:
 //
-//	T - The element type, which must be comparable.
+//	T - The element type; any type is allowed.
 //
 // Example usage:
 //
@@ -59,24 +81,125 @@ import (
 //	s.Push(10)
 //	val, _ := s.Pop()
 //	fmt.Println(val) // Output: 10
-type Stack[T comparable] struct {
+type Stack[T any] struct {
 	cap, top int
+	minCap   int // the initial capacity, which the stack never shrinks below
 	data     []T
-	lock     sync.RWMutex
+	mutex    sync.RWMutex
+	unsafe   bool   // true if the stack skips locking (see NewUnsafeStack)
+	maxSize  int    // largest number of elements observed
+	pushes   uint64 // total number of pushed elements
+	pops     uint64 // total number of popped elements
+}
+
+// Stats is a point-in-time snapshot of stack instrumentation counters.
+//
+// Fields:
+//   - Size: current number of elements
+//   - Cap: current capacity of the underlying slice
+//   - MaxSize: high-water mark, the largest size observed since creation
+//   - Pushes: total number of elements added
+//   - Pops: total number of elements removed by Pop and PushMonotonic
+type Stats struct {
+	Size    int
+	Cap     int
+	MaxSize int
+	Pushes  uint64
+	Pops    uint64
 }
 
+// defaultCapacity is the initial capacity of a stack created by NewStack.
+const defaultCapacity = 16
+
 // NewStack creates and returns a new Stack with a default initial capacity of 16.
 //
 // Complexity: O(1)
-func NewStack[T comparable]() *Stack[T] {
+func NewStack[T any]() *Stack[T] {
+	return NewStackWithCapacity[T](defaultCapacity)
+}
+
+// NewStackWithCapacity creates and returns a new Stack with an initial capacity of n,
+// avoiding repeated grow-copies when the final depth is known. The stack never shrinks
+// below this capacity. If n < 1, the capacity is 16.
+//
+// Complexity: O(n)
+func NewStackWithCapacity[T any](n int) *Stack[T] {
+	if n < 1 {
+		n = defaultCapacity
+	}
 	return &Stack[T]{
-		cap:  16,
-		top:  -1,
-		data: make([]T, 16),
-		lock: sync.RWMutex{},
+		cap:    n,
+		top:    -1,
+		minCap: n,
+		data:   make([]T, n),
+	}
+}
+
+// NewStackFromSlice creates and returns a new Stack holding the elements of vals, as if
+// they were pushed in order, so the last element of vals is the top of the stack.
+// The capacity is len(vals), but at least 16; after pops it may shrink, down to 16.
+// vals is copied and not retained.
+//
+// Complexity: O(n)
+func NewStackFromSlice[T any](vals []T) *Stack[T] {
+	s := NewStackWithCapacity[T](max(len(vals), defaultCapacity))
+	s.minCap = defaultCapacity
+	copy(s.data, vals)
+	s.top = len(vals) - 1
+	s.pushes = uint64(len(vals))
+	s.maxSize = len(vals)
+	return s
+}
+
+// NewUnsafeStack creates and returns a new Stack that does not lock its mutex.
+//
+// It offers the same API as a stack created by NewStack but avoids the locking
+// overhead, which dominates the cost of Push and Pop in single-goroutine algorithm
+// code such as DFS or expression evaluation. It must not be accessed by multiple
+// goroutines concurrently without external synchronization.
+//
+// Complexity: O(1)
+func NewUnsafeStack[T any]() *Stack[T] {
+	s := NewStack[T]()
+	s.unsafe = true
+	return s
+}
+
+// lock acquires the write lock unless the stack is unsafe.
+func (s *Stack[T]) lock() {
+	if !s.unsafe {
+		s.mutex.Lock()
+	}
+}
+
+// unlock releases the write lock unless the stack is unsafe.
+func (s *Stack[T]) unlock() {
+	if !s.unsafe {
+		s.mutex.Unlock()
+	}
+}
+
+// rlock acquires the read lock unless the stack is unsafe.
+func (s *Stack[T]) rlock() {
+	if !s.unsafe {
+		s.mutex.RLock()
+	}
+}
+
+// runlock releases the read lock unless the stack is unsafe.
+func (s *Stack[T]) runlock() {
+	if !s.unsafe {
+		s.mutex.RUnlock()
 	}
 }
 
+// pushed records a push in the instrumentation counters, after top was incremented.
+// The caller must hold the lock.
+func (s *Stack[T]) pushed() {
+	s.pushes++
+	s.maxSize = max(s.maxSize, s.top+1)
+}
+
 // increaseSize doubles the capacity of the underlying slice
 // while preserving existing elements.
 //
@@ -88,10 +211,42 @@ func NewStack[T comparable]() *Stack[T] {
 //
 // Complexity: O(N), where N is the current number of elements.
 func (s *Stack[T]) increaseSize() {
-	s.cap = s.cap * 2
-	newData := make([]T, s.cap)
-	copy(newData, s.data)
+	s.resize(s.cap * 2)
+}
+
+// resize moves the elements into a new slice of capacity newCap, which must hold
+// at least Size() elements.
+//
+// Complexity: O(N), where N is the current number of elements.
+func (s *Stack[T]) resize(newCap int) {
+	newData := make([]T, newCap)
+	copy(newData, s.data[:s.top+1])
 	s.data = newData
+	s.cap = newCap
+}
+
+// shrinkIfSparse halves the capacity once the stack is less than a quarter full, as
+// long as it stays at or above the initial capacity. Halving at a quarter, rather
+// than at half, leaves room to grow again before the next resize, keeping Push and
+// Pop O(1) amortized.
+//
+// Complexity: O(N) when resizing, O(1) otherwise.
+func (s *Stack[T]) shrinkIfSparse() {
+	for s.top+1 < s.cap/4 && s.cap/2 >= s.minCap {
+		s.resize(s.cap / 2)
+	}
+}
+
+// Shrink reduces the capacity of the stack to fit its current elements, but never
+// below the initial capacity, releasing the rest of the underlying slice.
+//
+// Complexity: O(N)
+func (s *Stack[T]) Shrink() {
+	s.lock()
+	defer s.unlock()
+	if newCap := max(s.top+1, s.minCap); newCap < s.cap {
+		s.resize(newCap)
+	}
 }
 
 // Push adds an element to the top of the stack.
@@ -105,46 +260,53 @@ func (s *Stack[T]) increaseSize() {
 //
 // Complexity: Amortized O(1)
 func (s *Stack[T]) Push(val T) (bool, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock()
+	defer s.unlock()
 	if s.top == s.cap-1 {
 		s.increaseSize()
 	}
 	s.top++
 	s.data[s.top] = val
+	s.pushed()
 	return true, nil
 }
 
 // Pop removes and returns the top element from the stack.
-// If the stack is empty, it returns an error.
+// If the stack is empty, it returns ErrEmpty.
 //
 // Algorithm:
 //  1. If the stack is empty, return an error.
-//  2. Retrieve the top element and decrement top pointer.
+//  2. Retrieve the top element, clear its slot and decrement top pointer.
+//  3. Halve the capacity if the stack is less than a quarter full.
 //
-// Complexity: O(1)
+// Complexity: Amortized O(1)
 func (s *Stack[T]) Pop() (T, error) {
 	var zero T
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock()
+	defer s.unlock()
 
 	if s.top == -1 {
-		return zero, errors.New("stack empty")
+		return zero, ErrEmpty
 	}
 
 	value := s.data[s.top]
+	s.data[s.top] = zero
 	s.top--
+	s.pops++
+	s.shrinkIfSparse()
 	return value, nil
 }
 
 // Peek returns the element at the top of the stack without removing it.
-// Returns an error if the stack is empty.
+// Returns ErrEmpty if the stack is empty.
 //
 // Complexity: O(1)
 func (s *Stack[T]) Peek() (T, error) {
 	var zero T
-	if s.IsEmpty() {
-		return zero, errors.New("stack empty")
+	s.rlock()
+	defer s.runlock()
+	if s.top == -1 {
+		return zero, ErrEmpty
 	}
 	return s.data[s.top], nil
 }
@@ -153,8 +315,8 @@ func (s *Stack[T]) Peek() (T, error) {
 //
 // Complexity: O(1)
 func (s *Stack[T]) Size() int {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock()
+	defer s.unlock()
 	return s.top + 1
 }
 
@@ -163,8 +325,8 @@ func (s *Stack[T]) Size() int {
 //
 // Complexity: O(1)
 func (s *Stack[T]) IsEmpty() bool {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
+	s.rlock()
+	defer s.runlock()
 	return s.top == -1
 }
 
@@ -173,11 +335,47 @@ func (s *Stack[T]) IsEmpty() bool {
 //
 // Complexity: O(1)
 func (s *Stack[T]) IsFull() bool {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
+	s.rlock()
+	defer s.runlock()
 	return s.top == s.cap-1
 }
 
+// Cap returns the current capacity of the stack, i.e. how many elements it can hold
+// before the underlying slice grows.
+//
+// Complexity: O(1)
+func (s *Stack[T]) Cap() int {
+	s.rlock()
+	defer s.runlock()
+	return s.cap
+}
+
+// Stats returns a snapshot of the stack's instrumentation counters: the current size
+// and capacity, the maximum size observed, and the total number of pushes and pops.
+// MaxSize is a good initial capacity for NewStackWithCapacity when creating similar
+// stacks later.
+//
+// ResetTo and Clear discard elements without counting them as pops, and do not reset
+// the counters, so they describe the whole lifetime of the stack.
+//
+// Example usage:
+//
+//	st := s.Stats()
+//	fmt.Printf("size=%d cap=%d peak=%d in=%d out=%d\n", st.Size, st.Cap, st.MaxSize, st.Pushes, st.Pops)
+//
+// Complexity: O(1)
+func (s *Stack[T]) Stats() Stats {
+	s.rlock()
+	defer s.runlock()
+	return Stats{
+		Size:    s.top + 1,
+		Cap:     s.cap,
+		MaxSize: s.maxSize,
+		Pushes:  s.pushes,
+		Pops:    s.pops,
+	}
+}
+
 // ValueAt returns the element at a specific position from the top of the stack (0-based index).
 //
 // Note
@@ -185,30 +383,204 @@ func (s *Stack[T]) IsFull() bool {
 //   - pos = 1 returns the element just below the top, and so on.
 //
 // Returns an error if:
-//   - The stack is empty
-//   - The position is out of range
+//   - The stack is empty (ErrEmpty)
+//   - The position is out of range (ErrInvalidPosition)
 //
 // Complexity: O(1)
 func (s *Stack[T]) ValueAt(pos int) (T, error) {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
+	s.rlock()
+	defer s.runlock()
 	var zero T
 	if s.top == -1 {
-		return zero, errors.New("stack empty")
+		return zero, ErrEmpty
 	}
 	if pos < 0 || pos >= s.top+1 {
-		return zero, errors.New("invalid position")
+		return zero, ErrInvalidPosition
 	}
 	return s.data[s.top-pos], nil
 }
 
-// Clear removes all elements from the stack and resets it to an empty state.
-// After clearing, the underlying slice is set to nil to free memory.
+// SwapTop exchanges the two topmost elements, like SWAP in Forth: ( a b -- b a ).
+// Returns ErrNotEnoughElements if the stack holds fewer than two elements.
+//
+// Complexity: O(1)
+func (s *Stack[T]) SwapTop() error {
+	s.lock()
+	defer s.unlock()
+	if s.top < 1 {
+		return ErrNotEnoughElements
+	}
+	s.data[s.top], s.data[s.top-1] = s.data[s.top-1], s.data[s.top]
+	return nil
+}
+
+// Dup pushes a copy of the top element, like DUP in Forth: ( a -- a a ).
+// Returns ErrEmpty if the stack is empty.
+//
+// Complexity: Amortized O(1)
+func (s *Stack[T]) Dup() error {
+	s.lock()
+	defer s.unlock()
+	if s.top == -1 {
+		return ErrEmpty
+	}
+	if s.top == s.cap-1 {
+		s.increaseSize()
+	}
+	s.data[s.top+1] = s.data[s.top]
+	s.top++
+	s.pushed()
+	return nil
+}
+
+// Rot moves the n-th element from the top (the top being the 1st) to the top, shifting
+// the elements above it down by one. Rot(3) is ROT in Forth: ( a b c -- b c a ),
+// Rot(2) is SwapTop and Rot(1) does nothing.
+// Returns ErrInvalidPosition if n < 1 or the stack holds fewer than n elements.
+//
+// Complexity: O(n)
+func (s *Stack[T]) Rot(n int) error {
+	s.lock()
+	defer s.unlock()
+	if n < 1 || n > s.top+1 {
+		return ErrInvalidPosition
+	}
+	bottom := s.top - n + 1
+	v := s.data[bottom]
+	copy(s.data[bottom:s.top], s.data[bottom+1:s.top+1])
+	s.data[s.top] = v
+	return nil
+}
+
+// PushMonotonic pops every element from the top for which keep(top, val) returns false,
+// then pushes val, all under one lock acquisition. It returns the popped elements, in
+// the order they were popped (nil if none).
+//
+// With keep(top, val) = top >= val, the stack stays non-increasing from bottom to top,
+// and every popped element has val as its next greater element.
+//
+// Example:
+//
+//	// next greater element of every value
+//	next := map[int]int{}
+//	s := stack.NewUnsafeStack[int]()
+//	for _, v := range []int{2, 1, 5, 3} {
+//	    for _, p := range s.PushMonotonic(v, func(top, v int) bool { return top >= v }) {
+//	        next[p] = v // next[1] = 5, next[2] = 5
+//	    }
+//	}
+//
+// The lock is held while keep runs, so keep must not use the stack.
+//
+// Complexity: O(k) for k popped elements, amortized O(1) over a sequence of pushes
+func (s *Stack[T]) PushMonotonic(val T, keep func(top, val T) bool) []T {
+	s.lock()
+	defer s.unlock()
+	var zero T
+	var popped []T
+	for s.top >= 0 && !keep(s.data[s.top], val) {
+		popped = append(popped, s.data[s.top])
+		s.data[s.top] = zero
+		s.top--
+	}
+	s.pops += uint64(len(popped))
+	if s.top == s.cap-1 {
+		s.increaseSize()
+	}
+	s.top++
+	s.data[s.top] = val
+	s.pushed()
+	return popped
+}
+
+// Checkpoint records the depth of a stack, as returned by Mark.
+type Checkpoint struct {
+	depth int
+}
+
+// Mark returns a Checkpoint recording the current depth of the stack, for rolling back
+// to it later with ResetTo, e.g. when leaving a scope in an interpreter.
+//
+// Example:
+//
+//	cp := s.Mark()
+//	s.Push(local1)
+//	s.Push(local2)
+//	s.ResetTo(cp) // local1 and local2 are discarded
 //
 // Complexity: O(1)
+func (s *Stack[T]) Mark() Checkpoint {
+	s.rlock()
+	defer s.runlock()
+	return Checkpoint{depth: s.top + 1}
+}
+
+// ResetTo discards every element pushed since the checkpoint was taken, restoring the
+// depth recorded by Mark. Checkpoints can be nested; resetting to an outer checkpoint
+// discards the elements of the inner scopes too.
+//
+// Returns ErrInvalidCheckpoint if the stack is already shallower than the checkpoint,
+// i.e. elements below the mark were popped since. Checkpoints only record a depth, so
+// using one taken on another stack is not detected.
+//
+// Complexity: O(k), where k = number of discarded elements, whose slots are cleared.
+func (s *Stack[T]) ResetTo(cp Checkpoint) error {
+	s.lock()
+	defer s.unlock()
+	if cp.depth > s.top+1 {
+		return ErrInvalidCheckpoint
+	}
+	clear(s.data[cp.depth : s.top+1])
+	s.top = cp.depth - 1
+	s.shrinkIfSparse()
+	return nil
+}
+
+// SearchFunc returns the 1-based distance from the top of the stack to the topmost
+// element satisfying match, or -1 if there is none. The top element is at distance 1.
+//
+// The read lock is held while match runs, so match must not use the stack.
+//
+// Complexity: O(N)
+func (s *Stack[T]) SearchFunc(match func(T) bool) int {
+	s.rlock()
+	defer s.runlock()
+	for i := s.top; i >= 0; i-- {
+		if match(s.data[i]) {
+			return s.top - i + 1
+		}
+	}
+	return -1
+}
+
+// Search returns the 1-based distance from the top of s to the topmost occurrence of
+// elem, or -1 if elem is not in the stack, matching java.util.Stack.search.
+//
+// It is a function rather than a method because it needs comparable elements, while
+// a Stack accepts any type; use SearchFunc for other element types.
+//
+// Example:
+//
+//	s.Push("a")
+//	s.Push("b")
+//	stack.Search(s, "b") // 1
+//	stack.Search(s, "a") // 2
+//	stack.Search(s, "z") // -1
+//
+// Complexity: O(N)
+func Search[T comparable](s *Stack[T], elem T) int {
+	return s.SearchFunc(func(v T) bool { return v == elem })
+}
+
+// Clear removes all elements from the stack and resets it to its initial capacity.
+// The underlying slice is replaced by a new one of the initial capacity, so the old
+// elements can be garbage collected and the stack stays usable.
+//
+// Complexity: O(C), where C is the initial capacity.
 func (s *Stack[T]) Clear() {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock()
+	defer s.unlock()
 	s.top = -1
-	s.data = nil
+	s.cap = s.minCap
+	s.data = make([]T, s.minCap)
 }
@@ -114,3 +114,23 @@ func BenchmarkStackPushLarge(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkUnsafeStackPushPop(b *testing.B) {
+	data := generateData(10000)
+	for _, bc := range []struct {
+		name string
+		s    *Stack[int]
+	}{{"Stack", NewStack[int]()}, {"UnsafeStack", NewUnsafeStack[int]()}} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, v := range data {
+					_, _ = bc.s.Push(v)
+				}
+				for range data {
+					_, _ = bc.s.Pop()
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package stack
 
 import (
+	"errors"
+	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -83,3 +86,404 @@ func TestStackBasicOperations(t *testing.T) {
 		t.Errorf("Expected %v, got %v", "stack empty", err)
 	}
 }
+
+func TestStackNonComparable(t *testing.T) {
+	type frame struct {
+		args []int
+		undo func() int
+	}
+	s := NewStack[frame]()
+	_, _ = s.Push(frame{args: []int{1, 2}, undo: func() int { return 1 }})
+	_, _ = s.Push(frame{args: []int{3}, undo: func() int { return 2 }})
+
+	f, err := s.Pop()
+	if err != nil || f.undo() != 2 || len(f.args) != 1 {
+		t.Errorf("Pop returned unexpected frame, err=%v", err)
+	}
+	if f, _ := s.Peek(); f.undo() != 1 || f.args[1] != 2 {
+		t.Errorf("Peek returned unexpected frame")
+	}
+
+	fs := NewStack[func() string]()
+	_, _ = fs.Push(func() string { return "undo" })
+	if fn, _ := fs.Pop(); fn() != "undo" {
+		t.Errorf("Expected to pop the pushed closure")
+	}
+}
+
+func TestStackUsableAfterClear(t *testing.T) {
+	s := NewStack[int]()
+	for i := 0; i < 40; i++ {
+		_, _ = s.Push(i)
+	}
+	s.Clear()
+	if !s.IsEmpty() || s.IsFull() {
+		t.Errorf("Expected empty stack after Clear")
+	}
+	for i := 0; i < 20; i++ {
+		if ok, err := s.Push(i); !ok || err != nil {
+			t.Fatalf("Push after Clear failed at i=%d, err=%v", i, err)
+		}
+	}
+	if v, err := s.Peek(); err != nil || v != 19 || s.Size() != 20 {
+		t.Errorf("Peek expected 19 with size 20, got %d with size %d", v, s.Size())
+	}
+}
+
+func TestStackPeekConcurrent(t *testing.T) {
+	s := NewStack[int]()
+	_, _ = s.Push(0)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			_, _ = s.Push(i)
+			_, _ = s.Pop()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			if _, err := s.Peek(); err != nil {
+				t.Errorf("Peek error: %v", err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func TestUnsafeStack(t *testing.T) {
+	s := NewUnsafeStack[string]()
+	for _, v := range []string{"a", "b", "c"} {
+		_, _ = s.Push(v)
+	}
+	if v, _ := s.Peek(); v != "c" || s.Size() != 3 {
+		t.Errorf("Peek expected c with size 3, got %s with size %d", v, s.Size())
+	}
+	for _, want := range []string{"c", "b", "a"} {
+		if v, err := s.Pop(); err != nil || v != want {
+			t.Errorf("Pop expected %s, got %s, err=%v", want, v, err)
+		}
+	}
+	if _, err := s.Pop(); err == nil || !s.IsEmpty() {
+		t.Errorf("Expected error when popping from empty stack")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	s := NewStack[string]()
+	if Search(s, "a") != -1 {
+		t.Errorf("Expected -1 for empty stack")
+	}
+	for _, v := range []string{"a", "b", "a", "c"} {
+		_, _ = s.Push(v)
+	}
+	for elem, want := range map[string]int{"c": 1, "a": 2, "b": 3, "z": -1} {
+		if got := Search(s, elem); got != want {
+			t.Errorf("Search(%s) = %d; want %d", elem, got, want)
+		}
+	}
+
+	type frame struct{ ids []int }
+	fs := NewStack[frame]()
+	_, _ = fs.Push(frame{ids: []int{1}})
+	_, _ = fs.Push(frame{ids: []int{2, 3}})
+	if got := fs.SearchFunc(func(f frame) bool { return f.ids[0] == 1 }); got != 2 {
+		t.Errorf("SearchFunc = %d; want 2", got)
+	}
+	if got := fs.SearchFunc(func(f frame) bool { return len(f.ids) > 5 }); got != -1 {
+		t.Errorf("SearchFunc = %d; want -1", got)
+	}
+}
+
+// contents returns the elements of s from bottom to top.
+func contents[T any](s *Stack[T]) []T {
+	vals := make([]T, s.Size())
+	for i := range vals {
+		vals[i], _ = s.ValueAt(len(vals) - 1 - i)
+	}
+	return vals
+}
+
+func TestTopOfStackOperations(t *testing.T) {
+	s := NewStack[int]()
+	if s.SwapTop() == nil || s.Dup() == nil || s.Rot(1) == nil {
+		t.Errorf("Expected errors on empty stack")
+	}
+	_, _ = s.Push(1)
+	if s.SwapTop() == nil {
+		t.Errorf("Expected SwapTop error with one element")
+	}
+	_, _ = s.Push(2)
+	_, _ = s.Push(3)
+
+	if err := s.Rot(3); err != nil {
+		t.Fatalf("Rot(3) error: %v", err)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []int{2, 3, 1}) {
+		t.Errorf("after Rot(3) = %v; want [2 3 1]", got)
+	}
+	if err := s.SwapTop(); err != nil {
+		t.Fatalf("SwapTop error: %v", err)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []int{2, 1, 3}) {
+		t.Errorf("after SwapTop = %v; want [2 1 3]", got)
+	}
+	if err := s.Dup(); err != nil {
+		t.Fatalf("Dup error: %v", err)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []int{2, 1, 3, 3}) {
+		t.Errorf("after Dup = %v; want [2 1 3 3]", got)
+	}
+	_ = s.Rot(1)
+	_ = s.Rot(2)
+	if got := contents(s); !reflect.DeepEqual(got, []int{2, 1, 3, 3}) {
+		t.Errorf("after Rot(1), Rot(2) = %v; want [2 1 3 3]", got)
+	}
+	if s.Rot(0) == nil || s.Rot(5) == nil {
+		t.Errorf("Expected errors for Rot out of range")
+	}
+
+	// Dup grows a full stack
+	full := NewStack[int]()
+	for i := 0; i < 16; i++ {
+		_, _ = full.Push(i)
+	}
+	if err := full.Dup(); err != nil || full.Size() != 17 {
+		t.Errorf("Dup on full stack failed, size %d, err=%v", full.Size(), err)
+	}
+}
+
+func TestStackShrink(t *testing.T) {
+	s := NewStack[*int]()
+	for i := 0; i < 1000; i++ {
+		_, _ = s.Push(&i)
+	}
+	if s.cap != 1024 {
+		t.Fatalf("Expected capacity 1024, got %d", s.cap)
+	}
+	for i := 0; i < 900; i++ {
+		_, _ = s.Pop()
+	}
+	if s.cap > 512 {
+		t.Errorf("Expected auto-shrink after large pops, capacity %d", s.cap)
+	}
+	for _, v := range s.data[s.top+1:] {
+		if v != nil {
+			t.Fatalf("Expected popped slots to be cleared")
+		}
+	}
+	s.Shrink()
+	if s.cap != 100 || s.Size() != 100 {
+		t.Errorf("Expected capacity 100 after Shrink, got %d", s.cap)
+	}
+	for i := 0; i < 95; i++ {
+		_, _ = s.Pop()
+	}
+	s.Shrink()
+	if s.cap != 16 || s.Size() != 5 {
+		t.Errorf("Expected capacity 16 after Shrink, got %d", s.cap)
+	}
+	if v, err := s.Peek(); err != nil || v == nil {
+		t.Errorf("Expected elements to survive shrinking, err=%v", err)
+	}
+}
+
+func TestNewStackWithCapacity(t *testing.T) {
+	s := NewStackWithCapacity[int](100)
+	for i := 0; i < 100; i++ {
+		_, _ = s.Push(i)
+	}
+	if !s.IsFull() || s.cap != 100 {
+		t.Errorf("Expected a full stack of capacity 100, got capacity %d", s.cap)
+	}
+	_, _ = s.Push(100)
+	for i := 0; i < 100; i++ {
+		_, _ = s.Pop()
+	}
+	s.Shrink()
+	if s.cap != 100 {
+		t.Errorf("Expected capacity not to shrink below 100, got %d", s.cap)
+	}
+	s.Clear()
+	if s.cap != 100 || len(s.data) != 100 {
+		t.Errorf("Expected capacity 100 after Clear, got %d", s.cap)
+	}
+	if NewStackWithCapacity[int](-1).cap != 16 {
+		t.Errorf("Expected capacity below 1 to default to 16")
+	}
+}
+
+func TestNewStackFromSlice(t *testing.T) {
+	vals := generateData(40)
+	s := NewStackFromSlice(vals)
+	vals[39] = -1
+	if s.Size() != 40 || s.cap != 40 {
+		t.Errorf("Expected size and capacity 40, got %d and %d", s.Size(), s.cap)
+	}
+	for i := 39; i >= 0; i-- {
+		if v, err := s.Pop(); err != nil || v != i {
+			t.Fatalf("Pop expected %d, got %d, err=%v", i, v, err)
+		}
+	}
+	if s.cap >= 40 || s.cap < 16 {
+		t.Errorf("Expected capacity to shrink towards 16, got %d", s.cap)
+	}
+
+	small := NewStackFromSlice([]string{"a", "b"})
+	if v, _ := small.Peek(); v != "b" || small.cap != 16 {
+		t.Errorf("Peek expected b with capacity 16, got %s with %d", v, small.cap)
+	}
+	if empty := NewStackFromSlice[int](nil); !empty.IsEmpty() {
+		t.Errorf("Expected empty stack from nil slice")
+	}
+}
+
+func TestMarkAndResetTo(t *testing.T) {
+	s := NewStack[string]()
+	_, _ = s.Push("global")
+	outer := s.Mark()
+	_, _ = s.Push("a")
+	inner := s.Mark()
+	_, _ = s.Push("b")
+	_, _ = s.Push("c")
+
+	if err := s.ResetTo(inner); err != nil {
+		t.Fatalf("ResetTo(inner) error: %v", err)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []string{"global", "a"}) {
+		t.Errorf("after ResetTo(inner) = %v", got)
+	}
+	if s.data[2] != "" || s.data[3] != "" {
+		t.Errorf("Expected discarded slots to be cleared")
+	}
+
+	_, _ = s.Push("d")
+	if err := s.ResetTo(outer); err != nil {
+		t.Fatalf("ResetTo(outer) error: %v", err)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []string{"global"}) {
+		t.Errorf("after ResetTo(outer) = %v", got)
+	}
+	// the stack is now shallower than the inner mark
+	if err := s.ResetTo(inner); err == nil {
+		t.Errorf("Expected error for a checkpoint deeper than the stack")
+	}
+	if err := s.ResetTo(NewStack[string]().Mark()); err != nil || !s.IsEmpty() {
+		t.Errorf("Expected ResetTo depth 0 to empty the stack, err=%v", err)
+	}
+}
+
+func TestPushMonotonicNextGreater(t *testing.T) {
+	vals := []int{2, 1, 2, 4, 3, 1, 5}
+	next := make([]int, len(vals))
+	for i := range next {
+		next[i] = -1
+	}
+	// the stack holds indices whose next greater element is not known yet
+	s := NewStack[int]()
+	for i, v := range vals {
+		for _, j := range s.PushMonotonic(i, func(top, i int) bool { return vals[top] >= vals[i] }) {
+			next[j] = v
+		}
+	}
+	if want := []int{4, 2, 4, 5, 5, 5, -1}; !reflect.DeepEqual(next, want) {
+		t.Errorf("next greater = %v; want %v", next, want)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []int{6}) {
+		t.Errorf("remaining indices = %v; want [6]", got)
+	}
+}
+
+func TestPushMonotonicPoppedOrder(t *testing.T) {
+	s := NewStackFromSlice([]int{9, 5, 3, 1})
+	popped := s.PushMonotonic(4, func(top, v int) bool { return top >= v })
+	if !reflect.DeepEqual(popped, []int{1, 3}) {
+		t.Errorf("popped = %v; want [1 3]", popped)
+	}
+	if got := contents(s); !reflect.DeepEqual(got, []int{9, 5, 4}) {
+		t.Errorf("stack = %v; want [9 5 4]", got)
+	}
+	if popped := s.PushMonotonic(2, func(top, v int) bool { return top >= v }); popped != nil {
+		t.Errorf("popped = %v; want nil", popped)
+	}
+}
+
+func TestStackStats(t *testing.T) {
+	s := NewStack[int]()
+	if st := s.Stats(); st != (Stats{Cap: 16}) {
+		t.Fatalf("Expected empty stats, got %+v", st)
+	}
+
+	for i := 0; i < 20; i++ {
+		s.Push(i)
+	}
+	if s.Cap() != 32 {
+		t.Errorf("Expected Cap 32 after growth, got %d", s.Cap())
+	}
+	_, _ = s.Pop()
+	_ = s.Dup()
+	s.PushMonotonic(100, func(top, v int) bool { return top >= v }) // pops all 20
+	_, _ = s.Pop()
+	_, _ = s.Pop() // empty, not counted
+
+	expected := Stats{Size: 0, Cap: 16, MaxSize: 20, Pushes: 22, Pops: 22}
+	if st := s.Stats(); st != expected {
+		t.Errorf("Expected %+v, got %+v", expected, st)
+	}
+
+	cp := s.Mark()
+	s.Push(1)
+	_ = s.ResetTo(cp)
+	s.Push(2)
+	s.Clear()
+	expected.Pushes = 24
+	if st := s.Stats(); st != expected {
+		t.Errorf("Expected %+v after ResetTo and Clear, got %+v", expected, st)
+	}
+
+	f := NewStackFromSlice([]int{1, 2, 3})
+	if st := f.Stats(); st != (Stats{Size: 3, Cap: 16, MaxSize: 3, Pushes: 3}) {
+		t.Errorf("Expected slice elements counted as pushes, got %+v", st)
+	}
+}
+
+func TestStackSentinelErrors(t *testing.T) {
+	s := NewStack[int]()
+	if _, err := s.Pop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Pop: expected ErrEmpty, got %v", err)
+	}
+	if _, err := s.Peek(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Peek: expected ErrEmpty, got %v", err)
+	}
+	if _, err := s.ValueAt(0); !errors.Is(err, ErrEmpty) {
+		t.Errorf("ValueAt: expected ErrEmpty, got %v", err)
+	}
+	if err := s.Dup(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Dup: expected ErrEmpty, got %v", err)
+	}
+
+	s.Push(1)
+	cp := s.Mark()
+	_, _ = s.Pop()
+	if err := s.ResetTo(cp); !errors.Is(err, ErrInvalidCheckpoint) {
+		t.Errorf("ResetTo: expected ErrInvalidCheckpoint, got %v", err)
+	}
+
+	s.Push(1)
+	if _, err := s.ValueAt(1); !errors.Is(err, ErrInvalidPosition) {
+		t.Errorf("ValueAt: expected ErrInvalidPosition, got %v", err)
+	}
+	if err := s.Rot(2); !errors.Is(err, ErrInvalidPosition) {
+		t.Errorf("Rot: expected ErrInvalidPosition, got %v", err)
+	}
+	if err := s.SwapTop(); !errors.Is(err, ErrNotEnoughElements) {
+		t.Errorf("SwapTop: expected ErrNotEnoughElements, got %v", err)
+	}
+
+	if _, err := NewMinStack[int]().Min(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Min: expected ErrEmpty, got %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+package trie
+
+// DoubleArrayTrie is a read-only, compact trie produced by Trie.Compile.
+//
+// It encodes the transitions of a byte-level trie over the UTF-8 encoding of the keys
+// in two parallel integer arrays (a double-array trie):
+//
+//   - base[s] + code is the state reached from state s through code
+//   - check[t] holds the parent of state t, so the transition is valid iff check[t] == s
+//
+// Byte b uses code b+1, and code 0 marks the end of a word. A lookup is therefore a
+// handful of array reads per byte, with no maps, pointers or per-node allocations, which
+// makes it a good fit for embedding large static dictionaries in services.
+//
+// A DoubleArrayTrie is immutable and safe for concurrent use without locking.
+//
+// Fields:
+//   - base: transition offsets, indexed by state
+//   - check: parent state of each state, or -1 for an unused slot
+//   - size: the number of words stored
+//
+// Example:
+//
+//	t := NewTrie()
+//	for _, w := range dictionary {
+//	    t.Insert(w)
+//	}
+//	dict := t.Compile()
+//	fmt.Println(dict.Search("gopher"))
+type DoubleArrayTrie struct {
+	base  []int32
+	check []int32
+	size  int
+}
+
+// Compile builds a read-only DoubleArrayTrie containing the words currently stored
+// in the Trie. Later changes to the Trie are not reflected in the result.
+//
+// Expired words (see InsertWithTTL) are not included.
+//
+// Algorithm Steps:
+//   - Collect the words in lexicographic order; rune order equals UTF-8 byte order.
+//   - Starting at the root, group the words of a state by their next byte.
+//   - Find the lowest base for which all child slots base+code are unused and claim them.
+//   - Recurse into every child group.
+//
+// Time Complexity: O(M * L + S * C), where M = number of words, L = average word
+// length, S = number of states and C = cost of finding a free base
+func (t *Trie) Compile() *DoubleArrayTrie {
+	t.mutex.RLock()
+	words := make([]string, 0, t.size)
+	var dfs func(node *Node, path []rune)
+	dfs = func(node *Node, path []rune) {
+		if node.isEnd {
+			if word := string(path); !t.expired(word) {
+				words = append(words, word)
+			}
+		}
+		for _, e := range node.sortedChildren() {
+			dfs(e.node, append(path, e.ch))
+		}
+	}
+	dfs(t.root, nil)
+	t.mutex.RUnlock()
+
+	b := &daBuilder{}
+	b.grow(1)
+	b.check[0] = 0 // the root is its own parent; no transition can reach slot 0
+	if len(words) > 0 {
+		b.build(words, 0, 0)
+	}
+	used := b.used + 1
+	return &DoubleArrayTrie{
+		base:  b.base[:used:used],
+		check: b.check[:used:used],
+		size:  len(words),
+	}
+}
+
+// daBuilder holds the intermediate state of Trie.Compile.
+type daBuilder struct {
+	base  []int32
+	check []int32
+	free  int // lowest slot that may be unused
+	used  int // highest slot in use
+}
+
+// grow extends the arrays so that slot n-1 exists.
+func (b *daBuilder) grow(n int) {
+	for len(b.check) < n {
+		b.base = append(b.base, 0)
+		b.check = append(b.check, -1)
+	}
+}
+
+// daGroup is a run of sorted keys that share the same code at the current depth.
+type daGroup struct {
+	code   int32
+	lo, hi int
+}
+
+// build lays out the children of state, which is reached by the first depth bytes of
+// every key in keys (keys are sorted and share that prefix).
+func (b *daBuilder) build(keys []string, depth int, state int32) {
+	var groups []daGroup
+	for i, key := range keys {
+		code := int32(0)
+		if len(key) > depth {
+			code = int32(key[depth]) + 1
+		}
+		if n := len(groups); n > 0 && groups[n-1].code == code {
+			groups[n-1].hi = i + 1
+			continue
+		}
+		groups = append(groups, daGroup{code, i, i + 1})
+	}
+
+	base := b.findBase(groups)
+	b.base[state] = base
+	// claim every child slot before recursing so that siblings are not reused
+	for _, g := range groups {
+		t := int(base + g.code)
+		b.check[t] = state
+		b.used = max(b.used, t)
+	}
+	for _, g := range groups {
+		if g.code != 0 {
+			b.build(keys[g.lo:g.hi], depth+1, base+g.code)
+		}
+	}
+}
+
+// findBase returns the lowest base >= 1 for which base+code is unused for all groups.
+func (b *daBuilder) findBase(groups []daGroup) int32 {
+	for b.free < len(b.check) && b.check[b.free] >= 0 {
+		b.free++
+	}
+	first := groups[0].code
+	for pos := b.free; ; pos++ {
+		b.grow(pos + 1)
+		if b.check[pos] >= 0 || int32(pos)-first < 1 {
+			continue
+		}
+		base := int32(pos) - first
+		ok := true
+		for _, g := range groups[1:] {
+			t := int(base + g.code)
+			b.grow(t + 1)
+			if b.check[t] >= 0 {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return base
+		}
+	}
+}
+
+// next returns the state reached from state s through code, or -1 if there is none.
+//
+// Time Complexity: O(1)
+func (da *DoubleArrayTrie) next(s int32, code int32) int32 {
+	t := da.base[s] + code
+	if t <= 0 || int(t) >= len(da.check) || da.check[t] != s {
+		return -1
+	}
+	return t
+}
+
+// find returns the state reached by key from the root, or -1 if there is none.
+//
+// Time Complexity: O(K), where K = length of key in bytes
+func (da *DoubleArrayTrie) find(key string) int32 {
+	s := int32(0)
+	for i := 0; i < len(key) && s >= 0; i++ {
+		s = da.next(s, int32(key[i])+1)
+	}
+	return s
+}
+
+// Search checks if a complete word exists in the DoubleArrayTrie.
+//
+// Time Complexity: O(N), where N = length of the word in bytes
+func (da *DoubleArrayTrie) Search(word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+	s := da.find(word)
+	return s >= 0 && da.next(s, 0) >= 0
+}
+
+// StartsWith checks if any word in the DoubleArrayTrie starts with the given prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix in bytes
+func (da *DoubleArrayTrie) StartsWith(prefix string) bool {
+	if len(prefix) == 0 {
+		return false
+	}
+	return da.find(prefix) >= 0
+}
+
+// GetWordsWithPrefix retrieves all words that start with the given prefix, in
+// lexicographic order.
+//
+// Time Complexity: O(K + V * 257), where V = number of visited states
+func (da *DoubleArrayTrie) GetWordsWithPrefix(prefix string) []string {
+	if len(prefix) == 0 {
+		return nil
+	}
+	s := da.find(prefix)
+	if s < 0 {
+		return nil
+	}
+	var result []string
+	var dfs func(s int32, path []byte)
+	dfs = func(s int32, path []byte) {
+		if da.next(s, 0) >= 0 {
+			result = append(result, string(path))
+		}
+		for code := int32(1); code <= 256; code++ {
+			if t := da.next(s, code); t >= 0 {
+				dfs(t, append(path, byte(code-1)))
+			}
+		}
+	}
+	dfs(s, []byte(prefix))
+	return result
+}
+
+// Size returns the number of words stored in the DoubleArrayTrie.
+//
+// Time Complexity: O(1)
+func (da *DoubleArrayTrie) Size() int {
+	return da.size
+}
+
+// IsEmpty returns true if the DoubleArrayTrie contains no words, false otherwise.
+//
+// Time Complexity: O(1)
+func (da *DoubleArrayTrie) IsEmpty() bool {
+	return da.size == 0
+}
+
+// MemoryBytes returns the size of the base and check arrays in bytes.
+//
+// Time Complexity: O(1)
+func (da *DoubleArrayTrie) MemoryBytes() int {
+	return 4 * (len(da.base) + len(da.check))
+}
@@ -0,0 +1,91 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDoubleArrayTrie(t *testing.T) {
+	tr := NewTrie()
+	words := []string{"a", "app", "apple", "application", "apply", "banana", "band", "日本", "日本語", "ü"}
+	for _, w := range words {
+		tr.Insert(w)
+	}
+	da := tr.Compile()
+
+	if da.Size() != len(words) || da.IsEmpty() {
+		t.Fatalf("expected size %d, got %d", len(words), da.Size())
+	}
+	for _, w := range words {
+		if !da.Search(w) {
+			t.Errorf("Search(%q) = false; want true", w)
+		}
+	}
+	for _, w := range []string{"ap", "applications", "ban", "日", "b", ""} {
+		if da.Search(w) {
+			t.Errorf("Search(%q) = true; want false", w)
+		}
+	}
+
+	prefixes := []struct {
+		prefix   string
+		expected bool
+	}{
+		{"ap", true},
+		{"appl", true},
+		{"band", true},
+		{"日", true},
+		{"bands", false},
+		{"c", false},
+		{"", false},
+	}
+	for _, tt := range prefixes {
+		if got := da.StartsWith(tt.prefix); got != tt.expected {
+			t.Errorf("StartsWith(%q) = %v; want %v", tt.prefix, got, tt.expected)
+		}
+	}
+
+	got := da.GetWordsWithPrefix("app")
+	if expected := []string{"app", "apple", "application", "apply"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetWordsWithPrefix(app) = %v; want %v", got, expected)
+	}
+	got = da.GetWordsWithPrefix("日")
+	if expected := []string{"日本", "日本語"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetWordsWithPrefix(日) = %v; want %v", got, expected)
+	}
+	if da.GetWordsWithPrefix("x") != nil || da.GetWordsWithPrefix("") != nil {
+		t.Errorf("expected nil for missing or empty prefix")
+	}
+
+	// the compiled trie is independent of later changes
+	tr.Insert("cherry")
+	if da.Search("cherry") {
+		t.Errorf("compiled trie observed a later insert")
+	}
+}
+
+func TestDoubleArrayTrieEmpty(t *testing.T) {
+	da := NewTrie().Compile()
+	if !da.IsEmpty() || da.Search("a") || da.StartsWith("a") {
+		t.Errorf("expected empty compiled trie")
+	}
+}
+
+func TestDoubleArrayTrieMatchesTrie(t *testing.T) {
+	tr := NewTrie()
+	for i := 0; i < 5000; i++ {
+		tr.Insert(string(rune('a'+i%26)) + string(rune('a'+i/26%26)) + string(rune('a'+i/676)))
+	}
+	da := tr.Compile()
+	if da.Size() != tr.Size() {
+		t.Fatalf("size mismatch: %d vs %d", da.Size(), tr.Size())
+	}
+	for w := range tr.Words() {
+		if !da.Search(w) {
+			t.Fatalf("Search(%q) = false; want true", w)
+		}
+	}
+	if got, expected := da.GetWordsWithPrefix("ab"), tr.GetWordsWithPrefixSorted("ab"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetWordsWithPrefix(ab) = %v; want %v", got, expected)
+	}
+}
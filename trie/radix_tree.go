@@ -0,0 +1,279 @@
+package trie
+
+import "sync"
+
+// radixNode represents a single node in a RadixTree.
+//
+// Each node contains:
+//   - label: the edge label leading from the parent to this node (one or more bytes).
+//   - children: a map from the first byte of a child's label to the child node.
+//   - isEnd: a boolean flag that indicates whether this node marks the end of a complete word.
+type radixNode struct {
+	label    string
+	children map[byte]*radixNode
+	isEnd    bool
+}
+
+// newRadixNode creates a node with the given edge label and an empty children map.
+func newRadixNode(label string, isEnd bool) *radixNode {
+	return &radixNode{label: label, children: make(map[byte]*radixNode), isEnd: isEnd}
+}
+
+// RadixTree is a thread-safe compressed prefix tree (Patricia tree).
+//
+// Unlike Trie, which allocates one node per rune, a RadixTree merges chains of
+// single-child nodes into a single edge labelled with the whole substring. For long
+// keys with shared prefixes (URLs, file paths) this cuts the number of nodes, and
+// therefore memory, several-fold while keeping the same Insert/Search/StartsWith API.
+//
+// Labels are stored and compared byte-wise; keys are reassembled by concatenating
+// labels, so multi-byte runes are always returned intact.
+//
+// Fields:
+//   - root: the root node of the tree (with an empty label)
+//   - size: the number of complete words stored in the tree
+//   - mutex: a read-write mutex (RWMutex) to ensure concurrent safety
+//
+// Example:
+//
+//	rt := NewRadixTree()
+//	rt.Insert("/api/v1/users")
+//	rt.Insert("/api/v1/orders")
+//	fmt.Println(rt.StartsWith("/api/v1")) // true
+type RadixTree struct {
+	root  *radixNode
+	size  int
+	mutex sync.RWMutex
+}
+
+// NewRadixTree creates and returns an empty RadixTree instance.
+func NewRadixTree() *RadixTree {
+	return &RadixTree{root: newRadixNode("", false)}
+}
+
+// commonPrefixLen returns the length in bytes of the longest common prefix of a and b.
+func commonPrefixLen(a, b string) int {
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Size returns the total number of complete words stored in the RadixTree.
+//
+// Time Complexity: O(1)
+func (rt *RadixTree) Size() int {
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+	return rt.size
+}
+
+// IsEmpty returns true if the RadixTree contains no words, false otherwise.
+//
+// Time Complexity: O(1)
+func (rt *RadixTree) IsEmpty() bool {
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+	return rt.size == 0
+}
+
+// Insert adds a word into the RadixTree.
+//
+// Algorithm Steps:
+//   - Starting from the root, follow the child whose label shares a prefix with the remaining key.
+//   - If the label is fully matched, consume it and continue from the child.
+//   - If only part of the label matches, split the edge at the mismatch into an intermediate node.
+//   - If no child matches, attach the remaining key as a new leaf.
+//
+// Time Complexity: O(N), where N = length of the word
+func (rt *RadixTree) Insert(word string) {
+	if len(word) == 0 {
+		return
+	}
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	node := rt.root
+	key := word
+	for len(key) > 0 {
+		child := node.children[key[0]]
+		if child == nil {
+			node.children[key[0]] = newRadixNode(key, true)
+			rt.size++
+			return
+		}
+		l := commonPrefixLen(key, child.label)
+		if l < len(child.label) {
+			// split the edge: node -> mid(label[:l]) -> child(label[l:])
+			mid := newRadixNode(child.label[:l], false)
+			child.label = child.label[l:]
+			mid.children[child.label[0]] = child
+			node.children[mid.label[0]] = mid
+			child = mid
+		}
+		key = key[l:]
+		node = child
+	}
+	if !node.isEnd {
+		node.isEnd = true
+		rt.size++
+	}
+}
+
+// findNode returns the node whose path from the root spells exactly key, or nil.
+//
+// Time Complexity: O(K), where K = length of the key
+func (rt *RadixTree) findNode(key string) *radixNode {
+	node := rt.root
+	for len(key) > 0 {
+		child := node.children[key[0]]
+		if child == nil || len(key) < len(child.label) || key[:len(child.label)] != child.label {
+			return nil
+		}
+		key = key[len(child.label):]
+		node = child
+	}
+	return node
+}
+
+// findPrefixNode returns the first node whose path from the root starts with prefix,
+// together with that full path, or nil if no stored path starts with prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (rt *RadixTree) findPrefixNode(prefix string) (*radixNode, string) {
+	node := rt.root
+	path := ""
+	key := prefix
+	for len(key) > 0 {
+		child := node.children[key[0]]
+		if child == nil {
+			return nil, ""
+		}
+		l := commonPrefixLen(key, child.label)
+		if l == len(key) {
+			// prefix ends inside (or at the end of) this edge
+			return child, path + child.label
+		}
+		if l < len(child.label) {
+			return nil, ""
+		}
+		path += child.label
+		key = key[l:]
+		node = child
+	}
+	return node, path
+}
+
+// Search checks if a complete word exists in the RadixTree.
+//
+// Time Complexity: O(N), where N = length of the word
+func (rt *RadixTree) Search(word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+	node := rt.findNode(word)
+	return node != nil && node.isEnd
+}
+
+// StartsWith checks if there is any word in the RadixTree that starts with the given prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (rt *RadixTree) StartsWith(prefix string) bool {
+	if len(prefix) == 0 {
+		return false
+	}
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+	node, _ := rt.findPrefixNode(prefix)
+	return node != nil
+}
+
+// GetWordsWithPrefix retrieves all words in the RadixTree that start with the given prefix.
+// The order of the results is not guaranteed.
+//
+// Time Complexity: O(K + M * L)
+//   - K = length of prefix
+//   - M = number of matching words
+//   - L = average length of matching words
+func (rt *RadixTree) GetWordsWithPrefix(prefix string) []string {
+	if len(prefix) == 0 {
+		return nil
+	}
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+	var result []string
+	node, path := rt.findPrefixNode(prefix)
+	if node == nil {
+		return result
+	}
+	var dfs func(node *radixNode, path string)
+	dfs = func(node *radixNode, path string) {
+		if node.isEnd {
+			result = append(result, path)
+		}
+		for _, child := range node.children {
+			dfs(child, path+child.label)
+		}
+	}
+	dfs(node, path)
+	return result
+}
+
+// mergeChild collapses node with its only child, concatenating their labels.
+func (n *radixNode) mergeChild() {
+	for _, child := range n.children {
+		n.label += child.label
+		n.children = child.children
+		n.isEnd = child.isEnd
+	}
+}
+
+// Remove deletes a word from the RadixTree if it exists.
+//
+// Returns true if the word was successfully removed, false otherwise.
+//
+// Algorithm Steps:
+//   - Locate the node for the word, remembering its parent.
+//   - Unmark the node as the end of a word.
+//   - If the node became a leaf, detach it, then merge the parent with its remaining child if possible.
+//   - If the node has exactly one child, merge it with that child to keep the tree compressed.
+//
+// Time Complexity: O(N), where N = length of the word
+func (rt *RadixTree) Remove(word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	var parent *radixNode
+	node := rt.root
+	key := word
+	for len(key) > 0 {
+		child := node.children[key[0]]
+		if child == nil || len(key) < len(child.label) || key[:len(child.label)] != child.label {
+			return false
+		}
+		key = key[len(child.label):]
+		parent = node
+		node = child
+	}
+	if !node.isEnd {
+		return false
+	}
+	node.isEnd = false
+	rt.size--
+
+	switch len(node.children) {
+	case 0:
+		delete(parent.children, node.label[0])
+		if parent != rt.root && !parent.isEnd && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		node.mergeChild()
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package trie
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRadixTreeInsertAndSearch(t *testing.T) {
+	rt := NewRadixTree()
+	words := []string{"hello", "helium", "he", "hero", "/api/v1/users", "/api/v1/orders", "日本", "日本語"}
+	for _, w := range words {
+		rt.Insert(w)
+	}
+	rt.Insert("hello")
+	rt.Insert("")
+
+	if rt.Size() != len(words) {
+		t.Fatalf("expected size %d, got %d", len(words), rt.Size())
+	}
+	for _, w := range words {
+		if !rt.Search(w) {
+			t.Errorf("Search(%q) = false; want true", w)
+		}
+	}
+	for _, w := range []string{"hel", "her", "/api", "日", ""} {
+		if rt.Search(w) {
+			t.Errorf("Search(%q) = true; want false", w)
+		}
+	}
+
+	prefixes := []struct {
+		prefix   string
+		expected bool
+	}{
+		{"he", true},
+		{"hel", true},
+		{"heli", true},
+		{"/api/v1/o", true},
+		{"/api/v2", false},
+		{"日本", true},
+		{"x", false},
+		{"", false},
+	}
+	for _, tt := range prefixes {
+		if got := rt.StartsWith(tt.prefix); got != tt.expected {
+			t.Errorf("StartsWith(%q) = %v; want %v", tt.prefix, got, tt.expected)
+		}
+	}
+}
+
+func TestRadixTreeGetWordsWithPrefix(t *testing.T) {
+	rt := NewRadixTree()
+	for _, w := range []string{"he", "hello", "helium", "hero", "world"} {
+		rt.Insert(w)
+	}
+
+	got := rt.GetWordsWithPrefix("hel")
+	sort.Strings(got)
+	expected := []string{"helium", "hello"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetWordsWithPrefix(hel) = %v; want %v", got, expected)
+	}
+
+	got = rt.GetWordsWithPrefix("h")
+	sort.Strings(got)
+	expected = []string{"he", "helium", "hello", "hero"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetWordsWithPrefix(h) = %v; want %v", got, expected)
+	}
+
+	if got := rt.GetWordsWithPrefix("wx"); len(got) != 0 {
+		t.Errorf("expected no matches, got %v", got)
+	}
+	if got := rt.GetWordsWithPrefix(""); got != nil {
+		t.Errorf("expected nil for empty prefix, got %v", got)
+	}
+}
+
+func TestRadixTreeRemove(t *testing.T) {
+	rt := NewRadixTree()
+	for _, w := range []string{"he", "hello", "helium", "hero"} {
+		rt.Insert(w)
+	}
+
+	if rt.Remove("hel") || rt.Remove("unknown") || rt.Remove("") {
+		t.Errorf("Remove of missing word should return false")
+	}
+	if !rt.Remove("he") {
+		t.Errorf("Remove(he) = false; want true")
+	}
+	if rt.Search("he") || !rt.StartsWith("he") {
+		t.Errorf("he should be removed while its descendants remain")
+	}
+	if !rt.Remove("hello") {
+		t.Errorf("Remove(hello) = false; want true")
+	}
+	if !rt.Search("helium") || !rt.Search("hero") {
+		t.Errorf("remaining words should still be found")
+	}
+	if !rt.Remove("helium") || !rt.Remove("hero") {
+		t.Errorf("Remove of remaining words should succeed")
+	}
+	if !rt.IsEmpty() || rt.StartsWith("h") {
+		t.Errorf("expected empty tree after removing all words")
+	}
+
+	// compression is restored after removals
+	rt.Insert("team")
+	rt.Insert("tea")
+	rt.Remove("tea")
+	if child := rt.root.children['t']; child == nil || child.label != "team" {
+		t.Errorf("expected single compressed edge 'team', got %+v", child)
+	}
+}
@@ -0,0 +1,153 @@
+package trie
+
+import (
+	"runtime"
+	"unicode/utf8"
+)
+
+// ShardedTrie is a concurrent Trie that spreads words across independent shards so
+// that concurrent inserts (e.g. parallel bulk loading) scale with the number of cores
+// instead of serializing on a single RWMutex.
+//
+// Words are routed to a shard by their first rune. Because every word sharing a
+// non-empty prefix also shares its first rune, prefix queries (StartsWith,
+// GetWordsWithPrefix, CountWordsWithPrefix, ...) only need to consult a single shard.
+//
+// Note: keys whose first runes hash to the same shard still share a lock, so the
+// speed-up depends on how evenly the first runes of the keys are distributed.
+//
+// Fields:
+//   - shards: independent Tries, each protected by its own RWMutex
+//
+// Example:
+//
+//	st := NewShardedTrie(16)
+//	var wg sync.WaitGroup
+//	for _, batch := range batches {
+//	    wg.Add(1)
+//	    go func(words []string) {
+//	        defer wg.Done()
+//	        for _, w := range words {
+//	            st.Insert(w)
+//	        }
+//	    }(batch)
+//	}
+//	wg.Wait()
+type ShardedTrie struct {
+	shards []*Trie
+}
+
+// NewShardedTrie creates an empty ShardedTrie with the given number of shards.
+// Options are applied to every shard.
+//
+// If shards <= 0, the number of shards defaults to runtime.GOMAXPROCS(0).
+func NewShardedTrie(shards int, opts ...Option) *ShardedTrie {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	st := &ShardedTrie{shards: make([]*Trie, shards)}
+	for i := range st.shards {
+		st.shards[i] = NewTrie(opts...)
+	}
+	return st
+}
+
+// shardFor returns the shard responsible for keys starting with the first rune of key.
+//
+// Time Complexity: O(1)
+func (st *ShardedTrie) shardFor(key string) *Trie {
+	r, _ := utf8.DecodeRuneInString(key)
+	// mix the rune so that neighbouring letters land on different shards
+	h := uint32(r) * 2654435761
+	return st.shards[h%uint32(len(st.shards))]
+}
+
+// Insert adds a word into the shard owning its first rune.
+//
+// Time Complexity: O(N), where N = length of the word
+func (st *ShardedTrie) Insert(word string) {
+	if len(word) == 0 {
+		return
+	}
+	st.shardFor(word).Insert(word)
+}
+
+// Search checks if a complete word exists in the ShardedTrie.
+//
+// Time Complexity: O(N), where N = length of the word
+func (st *ShardedTrie) Search(word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+	return st.shardFor(word).Search(word)
+}
+
+// StartsWith checks if any word in the ShardedTrie starts with the given prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (st *ShardedTrie) StartsWith(prefix string) bool {
+	if len(prefix) == 0 {
+		return false
+	}
+	return st.shardFor(prefix).StartsWith(prefix)
+}
+
+// GetWordsWithPrefix retrieves all words that start with the given prefix.
+// The order of the results is not guaranteed.
+//
+// Time Complexity: O(K + M * L)
+func (st *ShardedTrie) GetWordsWithPrefix(prefix string) []string {
+	if len(prefix) == 0 {
+		return nil
+	}
+	return st.shardFor(prefix).GetWordsWithPrefix(prefix)
+}
+
+// CountWordsWithPrefix returns the number of words that start with the given prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (st *ShardedTrie) CountWordsWithPrefix(prefix string) int {
+	if len(prefix) == 0 {
+		return 0
+	}
+	return st.shardFor(prefix).CountWordsWithPrefix(prefix)
+}
+
+// Remove deletes a word from the ShardedTrie if it exists.
+//
+// Returns true if the word was successfully removed, false otherwise.
+//
+// Time Complexity: O(N), where N = length of the word
+func (st *ShardedTrie) Remove(word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+	return st.shardFor(word).Remove(word)
+}
+
+// Size returns the total number of words across all shards.
+//
+// The result is a best-effort snapshot when the ShardedTrie is modified concurrently.
+//
+// Time Complexity: O(S), where S = number of shards
+func (st *ShardedTrie) Size() int {
+	total := 0
+	for _, shard := range st.shards {
+		total += shard.Size()
+	}
+	return total
+}
+
+// IsEmpty returns true if no shard contains any word.
+//
+// Time Complexity: O(S), where S = number of shards
+func (st *ShardedTrie) IsEmpty() bool {
+	return st.Size() == 0
+}
+
+// Shards returns the number of shards.
+//
+// Time Complexity: O(1)
+func (st *ShardedTrie) Shards() int {
+	return len(st.shards)
+}
@@ -0,0 +1,74 @@
+package trie
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestShardedTrieOperations(t *testing.T) {
+	st := NewShardedTrie(4)
+	if !st.IsEmpty() || st.Shards() != 4 {
+		t.Fatalf("expected empty trie with 4 shards")
+	}
+	for _, w := range []string{"he", "hello", "helium", "world", "word", ""} {
+		st.Insert(w)
+	}
+	if st.Size() != 5 {
+		t.Errorf("expected size 5, got %d", st.Size())
+	}
+	if !st.Search("hello") || st.Search("hel") || st.Search("") {
+		t.Errorf("Search returned unexpected result")
+	}
+	if !st.StartsWith("wor") || st.StartsWith("x") || st.StartsWith("") {
+		t.Errorf("StartsWith returned unexpected result")
+	}
+
+	got := st.GetWordsWithPrefix("hel")
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"helium", "hello"}) {
+		t.Errorf("GetWordsWithPrefix(hel) = %v", got)
+	}
+	if st.GetWordsWithPrefix("") != nil {
+		t.Errorf("expected nil for empty prefix")
+	}
+	if n := st.CountWordsWithPrefix("wo"); n != 2 {
+		t.Errorf("CountWordsWithPrefix(wo) = %d; want 2", n)
+	}
+	if st.CountWordsWithPrefix("") != 0 {
+		t.Errorf("expected 0 for empty prefix")
+	}
+
+	if !st.Remove("word") || st.Remove("word") || st.Remove("") {
+		t.Errorf("Remove returned unexpected result")
+	}
+	if st.Size() != 4 {
+		t.Errorf("expected size 4, got %d", st.Size())
+	}
+}
+
+func TestShardedTrieConcurrentInsert(t *testing.T) {
+	st := NewShardedTrie(0)
+	const goroutines, perG = 16, 500
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perG; i++ {
+				st.Insert(fmt.Sprintf("%c-word-%d-%d", 'a'+rune(g), g, i))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if st.Size() != goroutines*perG {
+		t.Fatalf("expected size %d, got %d", goroutines*perG, st.Size())
+	}
+	if n := st.CountWordsWithPrefix("c-word-2-"); n != perG {
+		t.Errorf("CountWordsWithPrefix = %d; want %d", n, perG)
+	}
+}
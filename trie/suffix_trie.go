@@ -0,0 +1,108 @@
+package trie
+
+// SuffixTrie indexes every suffix of the added texts so that substring queries can be
+// answered in time proportional to the length of the query.
+//
+// It reuses the Trie node machinery: each suffix is inserted as a path from the root,
+// and the per-node count records how many suffixes pass through a node. Because every
+// occurrence of a substring is the prefix of exactly one suffix, that count is the
+// number of occurrences of the substring across all indexed texts.
+//
+// Note: a suffix trie holds O(n^2) nodes for a text of length n, so it is intended for
+// short to medium documents (titles, identifiers, log lines) rather than large corpora.
+//
+// Fields:
+//   - trie: the underlying Trie holding all suffixes (its mutex guards the SuffixTrie)
+//   - texts: the number of texts added
+//
+// Example:
+//
+//	st := NewSuffixTrie()
+//	st.Add("banana")
+//	fmt.Println(st.ContainsSubstring("nan")) // true
+//	fmt.Println(st.CountOccurrences("an"))   // 2
+type SuffixTrie struct {
+	trie  *Trie
+	texts int
+}
+
+// NewSuffixTrie creates and returns an empty SuffixTrie instance.
+func NewSuffixTrie() *SuffixTrie {
+	return &SuffixTrie{trie: NewTrie()}
+}
+
+// Add indexes all suffixes of text.
+//
+// Algorithm Steps:
+//   - For each starting position i, walk the suffix text[i:] from the root.
+//   - Create missing nodes and increment the count of every node on the path.
+//   - Mark the node at the end of the suffix as terminal.
+//
+// Time Complexity: O(N^2), where N = length of the text
+func (st *SuffixTrie) Add(text string) {
+	if len(text) == 0 {
+		return
+	}
+	runes := []rune(text)
+	st.trie.mutex.Lock()
+	defer st.trie.mutex.Unlock()
+	root := st.trie.mutableRoot()
+	for i := range runes {
+		current := root
+		current.count++
+		for _, ch := range runes[i:] {
+			next := st.trie.mutableChild(current, ch)
+			if next == nil {
+				next = st.trie.newNode()
+				current.setChild(ch, next, st.trie.slots)
+			}
+			current = next
+			current.count++
+		}
+		if !current.isEnd {
+			current.isEnd = true
+			st.trie.size++
+		}
+	}
+	st.texts++
+}
+
+// ContainsSubstring reports whether s occurs in any of the indexed texts.
+//
+// Time Complexity: O(K), where K = length of s
+func (st *SuffixTrie) ContainsSubstring(s string) bool {
+	return st.trie.StartsWith(s)
+}
+
+// CountOccurrences returns the total number of (possibly overlapping) occurrences of s
+// across all indexed texts.
+//
+// Time Complexity: O(K), where K = length of s
+func (st *SuffixTrie) CountOccurrences(s string) int {
+	if len(s) == 0 {
+		return 0
+	}
+	st.trie.mutex.RLock()
+	defer st.trie.mutex.RUnlock()
+	node := st.trie.findNodeForPrefix(s)
+	if node == nil {
+		return 0
+	}
+	return node.count
+}
+
+// Size returns the number of texts added to the SuffixTrie.
+//
+// Time Complexity: O(1)
+func (st *SuffixTrie) Size() int {
+	st.trie.mutex.RLock()
+	defer st.trie.mutex.RUnlock()
+	return st.texts
+}
+
+// IsEmpty returns true if no text has been added, false otherwise.
+//
+// Time Complexity: O(1)
+func (st *SuffixTrie) IsEmpty() bool {
+	return st.Size() == 0
+}
@@ -0,0 +1,59 @@
+package trie
+
+import "testing"
+
+func TestSuffixTrie(t *testing.T) {
+	st := NewSuffixTrie()
+	if !st.IsEmpty() {
+		t.Fatalf("expected empty suffix trie")
+	}
+	st.Add("banana")
+	st.Add("bandana")
+	st.Add("")
+
+	if st.Size() != 2 {
+		t.Errorf("expected 2 texts, got %d", st.Size())
+	}
+
+	contains := []struct {
+		s        string
+		expected bool
+	}{
+		{"nan", true},
+		{"dan", true},
+		{"banana", true},
+		{"a", true},
+		{"nab", false},
+		{"bandanas", false},
+		{"", false},
+	}
+	for _, tt := range contains {
+		if got := st.ContainsSubstring(tt.s); got != tt.expected {
+			t.Errorf("ContainsSubstring(%q) = %v; want %v", tt.s, got, tt.expected)
+		}
+	}
+
+	counts := []struct {
+		s        string
+		expected int
+	}{
+		{"a", 6},
+		{"an", 4},
+		{"ana", 3},
+		{"ban", 2},
+		{"nd", 1},
+		{"x", 0},
+		{"", 0},
+	}
+	for _, tt := range counts {
+		if got := st.CountOccurrences(tt.s); got != tt.expected {
+			t.Errorf("CountOccurrences(%q) = %d; want %d", tt.s, got, tt.expected)
+		}
+	}
+
+	// duplicate texts count their occurrences twice
+	st.Add("banana")
+	if got := st.CountOccurrences("nana"); got != 2 {
+		t.Errorf("CountOccurrences(nana) = %d; want 2", got)
+	}
+}
@@ -0,0 +1,112 @@
+package trie
+
+import (
+	"slices"
+
+	"github.com/Zubayear/ryushin/priorityqueue"
+)
+
+// suggestMaxDistance is the largest edit distance considered by SuggestCorrections.
+// Almost all misspellings are within two edits of the intended word.
+const suggestMaxDistance = 2
+
+// suggestion is a candidate correction considered by SuggestCorrections.
+type suggestion struct {
+	word     string
+	distance int
+	weight   float64
+}
+
+// better reports whether a ranks before b: closer words first, then heavier words,
+// then lexicographic order.
+func (a suggestion) better(b suggestion) bool {
+	if a.distance != b.distance {
+		return a.distance < b.distance
+	}
+	if a.weight != b.weight {
+		return a.weight > b.weight
+	}
+	return a.word < b.word
+}
+
+// InsertWithWeight adds a word into the Trie with a ranking weight, such as its
+// frequency in a corpus or its popularity. Heavier words are preferred by
+// SuggestCorrections among candidates at the same edit distance.
+//
+// Inserting an existing word again replaces its weight; a plain Insert leaves it
+// unchanged. Words inserted without a weight have weight 0.
+//
+// Time Complexity: O(N), where N = length of the word
+func (t *Trie) InsertWithWeight(word string, weight float64) {
+	if len(word) == 0 {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.purgeExpired()
+	delete(t.expiry, word)
+	t.insert(word)
+	if t.weights == nil {
+		t.weights = make(map[string]float64)
+	}
+	t.weights[word] = weight
+}
+
+// Weight returns the ranking weight of word, or 0 if it has none.
+//
+// Time Complexity: O(1)
+func (t *Trie) Weight(word string) float64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.weights[word]
+}
+
+// SuggestCorrections returns up to k words of the Trie that are the best corrections
+// for word, best first.
+//
+// Candidates are the words within two edits (Levenshtein distance) of word, including
+// word itself if it is stored. They are ranked by edit distance, then by weight (see
+// InsertWithWeight), then lexicographically.
+//
+// Algorithm Steps:
+//   - Enumerate candidates with the bounded edit-distance search of SearchFuzzy.
+//   - Keep the k best candidates in a heap ordered worst-first, evicting the worst
+//     whenever the heap grows beyond k.
+//   - Drain the heap and reverse it to get the best-first order.
+//
+// Example:
+//
+//	t.InsertWithWeight("their", 900)
+//	t.InsertWithWeight("there", 1200)
+//	t.InsertWithWeight("tier", 50)
+//	t.SuggestCorrections("thier", 2) // [tier there]: one edit beats two edits
+//
+// Time Complexity: O(V * N + C log k)
+//   - V = number of visited nodes, N = length of the word, C = number of candidates
+func (t *Trie) SuggestCorrections(word string, k int) []string {
+	if len(word) == 0 || k <= 0 {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	// worst candidate at the top, so it is the one evicted
+	worst := priorityqueue.NewBinaryHeapWithComparator(func(a, b suggestion) bool {
+		return b.better(a)
+	})
+	t.fuzzy([]rune(word), suggestMaxDistance, func(match string, distance int) {
+		if len(t.expiry) > 0 && t.expired(match) {
+			return
+		}
+		worst.Add(suggestion{match, distance, t.weights[match]})
+		if worst.Size() > k {
+			_, _ = worst.Poll()
+		}
+	})
+	result := make([]string, 0, worst.Size())
+	for !worst.IsEmpty() {
+		s, _ := worst.Poll()
+		result = append(result, s.word)
+	}
+	slices.Reverse(result)
+	return result
+}
@@ -0,0 +1,59 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTrieSuggestCorrections(t *testing.T) {
+	tr := NewTrie()
+	tr.InsertWithWeight("their", 900)
+	tr.InsertWithWeight("there", 1200)
+	tr.InsertWithWeight("tier", 50)
+	tr.InsertWithWeight("three", 300)
+	tr.Insert("thee")
+	tr.Insert("apple")
+
+	tests := []struct {
+		word     string
+		k        int
+		expected []string
+	}{
+		{"thier", 2, []string{"tier", "there"}},
+		{"thier", 10, []string{"tier", "there", "their", "three", "thee"}},
+		{"tier", 1, []string{"tier"}},
+		{"xyzzy", 3, []string{}},
+		{"thier", 0, nil},
+		{"", 3, nil},
+	}
+	for _, tt := range tests {
+		if got := tr.SuggestCorrections(tt.word, tt.k); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("SuggestCorrections(%q, %d) = %v; want %v", tt.word, tt.k, got, tt.expected)
+		}
+	}
+
+	if tr.Weight("there") != 1200 || tr.Weight("thee") != 0 {
+		t.Errorf("unexpected weights")
+	}
+	tr.Insert("there")
+	if tr.Weight("there") != 1200 {
+		t.Errorf("plain Insert should keep the weight")
+	}
+	tr.Remove("there")
+	tr.Insert("there")
+	if tr.Weight("there") != 0 {
+		t.Errorf("Remove should drop the weight")
+	}
+}
+
+func TestTrieSuggestCorrectionsSkipsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	tr := NewTrie(WithClock(clock.Now))
+	tr.Insert("cat")
+	tr.InsertWithTTL("cart", time.Minute)
+	clock.now = clock.now.Add(time.Minute)
+	if got := tr.SuggestCorrections("cas", 5); !reflect.DeepEqual(got, []string{"cat"}) {
+		t.Errorf("SuggestCorrections(cas) = %v; want [cat]", got)
+	}
+}
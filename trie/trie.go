@@ -9,8 +9,33 @@ following features:
   - Search: Check if a string exists in the trie in O(n) time.
   - StartsWith: Check if any string in the trie starts with a given prefix in O(n) time.
   - Delete: Remove a string from the trie, adjusting nodes as needed in O(n) time.
+  - GetWordsWithPrefixSorted: Deterministic, lexicographically ordered prefix results.
+  - GetWordsWithPrefixN: Paginated, bounded prefix queries.
+  - CountWordsWithPrefix: Count words under a prefix in O(len(prefix)).
+  - RemovePrefix: Delete every word under a prefix in one operation.
+  - Byte-slice API: InsertBytes, SearchBytes, StartsWithBytes, ... avoid string conversions.
+  - Words / WordsWithPrefix: Lazily iterate words with range-over-func (iter.Seq).
+  - LongestCommonPrefix: Longest prefix shared by all stored words.
+  - Stats: Report node count, word count, max depth and estimated memory footprint.
+  - SearchFuzzy: Find words within a Levenshtein distance of a query (spell correction).
+  - SuggestCorrections: The k best corrections, ranked by edit distance and word weight.
+  - Segment: Split a string into dictionary words (word break).
+  - Snapshot: O(1) copy-on-write, point-in-time copies for lock-free readers.
+  - InsertWithTTL: Words that expire after a duration, with lazy and background cleanup.
+  - EndsWith / GetWordsWithSuffix: Suffix queries, indexed by an optional reversed Trie.
   - Thread Safety: All operations are concurrency-safe using sync.RWMutex.
 
+Variants:
+  - TrieMap[V]: a map-style trie that stores a value of type V for every key,
+    with Insert(key, value), Get(key) and prefix queries returning key/value pairs.
+  - RadixTree: a compressed (Patricia) tree that merges single-child chains into edge
+    labels, reducing memory for long keys such as URLs or file paths.
+  - SuffixTrie: indexes all suffixes of documents for substring search and counting.
+  - DoubleArrayTrie: a compact, read-only double-array trie built by Trie.Compile for
+    large static dictionaries.
+  - ShardedTrie: splits words across independently locked Tries by first rune so that
+    concurrent inserts scale with the number of cores.
+
 Use Cases:
   - Autocomplete systems
   - Spell checking
@@ -28,6 +53,8 @@ Example usage:
 
 Implementation Details:
   - Each node contains a map of rune to *Node for children.
+  - Optionally (WithASCIIChildren / WithLowercaseChildren) nodes use a fixed-size child
+    array for ASCII or lowercase runes, falling back to the map for other runes.
   - An `isEnd` flag marks the end of a valid word.
   - The trie dynamically grows as new words are added.
   - A stack from github.com/Zubayear/ryushin/stack may be used internally for traversal or deletion.
@@ -44,8 +71,16 @@ Space Complexity:
 package trie
 
 import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
 
+	"github.com/Zubayear/ryushin/priorityqueue"
 	"github.com/Zubayear/ryushin/stack"
 )
 
@@ -53,10 +88,18 @@ import (
 //
 // Each node contains:
 //   - children: a map of rune to Node pointers representing possible next characters.
+//   - slots: an optional fixed-size child array used by compact layouts (see WithASCIIChildren);
+//     runes outside the array's range still fall back to the children map.
 //   - isEnd: a boolean flag that indicates whether this node marks the end of a complete word.
+//   - count: the number of complete words in the subtree rooted at this node (including itself).
+//   - gen: the generation that owns the node; nodes of older generations are shared with
+//     snapshots and are copied before being modified (see Trie.Snapshot).
 type Node struct {
 	children map[rune]*Node // maps each character to its next node
+	slots    []*Node        // compact child array indexed by slotIndex, nil for map-only nodes
 	isEnd    bool           // true if this node marks the end of a valid word
+	count    int            // number of words ending at or below this node
+	gen      uint64         // generation of the Trie that owns (and may modify) this node
 }
 
 // NewTrieNode creates and returns a new Trie node.
@@ -64,8 +107,164 @@ type Node struct {
 // The returned node has:
 //   - an empty children map
 //   - isEnd set to false
+//   - count set to 0
 func NewTrieNode() *Node {
-	return &Node{make(map[rune]*Node), false}
+	return &Node{children: make(map[rune]*Node)}
+}
+
+// Supported sizes of the compact child array.
+const (
+	lowercaseSlots = 26  // 'a'..'z'
+	asciiSlots     = 128 // 0..127
+)
+
+// slotIndex maps ch to its position in a child array of the given size,
+// or returns -1 if ch falls outside the array's range.
+func slotIndex(size int, ch rune) int {
+	switch size {
+	case lowercaseSlots:
+		if ch >= 'a' && ch <= 'z' {
+			return int(ch - 'a')
+		}
+	case asciiSlots:
+		if ch >= 0 && ch < asciiSlots {
+			return int(ch)
+		}
+	}
+	return -1
+}
+
+// slotRune is the inverse of slotIndex.
+func slotRune(size int, i int) rune {
+	if size == lowercaseSlots {
+		return 'a' + rune(i)
+	}
+	return rune(i)
+}
+
+// child returns the child reached through ch, or nil if there is none.
+//
+// Time Complexity: O(1)
+func (n *Node) child(ch rune) *Node {
+	if i := slotIndex(len(n.slots), ch); i >= 0 {
+		return n.slots[i]
+	}
+	return n.children[ch]
+}
+
+// setChild links c as the child reached through ch.
+//
+// size is the compact child array size configured for the Trie; the array is
+// allocated lazily on the first child that fits into it, so leaves stay small.
+//
+// Time Complexity: O(1)
+func (n *Node) setChild(ch rune, c *Node, size int) {
+	if i := slotIndex(size, ch); i >= 0 {
+		if n.slots == nil {
+			n.slots = make([]*Node, size)
+		}
+		n.slots[i] = c
+		return
+	}
+	if n.children == nil {
+		n.children = make(map[rune]*Node)
+	}
+	n.children[ch] = c
+}
+
+// deleteChild unlinks the child reached through ch.
+//
+// Time Complexity: O(1)
+func (n *Node) deleteChild(ch rune) {
+	if i := slotIndex(len(n.slots), ch); i >= 0 {
+		n.slots[i] = nil
+		return
+	}
+	delete(n.children, ch)
+}
+
+// numChildren returns the number of children of the node.
+//
+// Time Complexity: O(1) for map-only nodes, O(S) with a child array of size S
+func (n *Node) numChildren() int {
+	count := len(n.children)
+	for _, c := range n.slots {
+		if c != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// all returns an iterator over the (rune, child) pairs of the node.
+// Array slots are visited in rune order, followed by the map entries in map order.
+func (n *Node) all() iter.Seq2[rune, *Node] {
+	return func(yield func(rune, *Node) bool) {
+		for i, c := range n.slots {
+			if c != nil && !yield(slotRune(len(n.slots), i), c) {
+				return
+			}
+		}
+		for ch, c := range n.children {
+			if !yield(ch, c) {
+				return
+			}
+		}
+	}
+}
+
+// sortedChildren returns the (rune, child) pairs of the node ordered by rune.
+//
+// Time Complexity: O(C log C), where C = number of children
+func (n *Node) sortedChildren() []childEntry {
+	entries := make([]childEntry, 0, n.numChildren())
+	for ch, c := range n.all() {
+		entries = append(entries, childEntry{ch, c})
+	}
+	// child array slots are already ordered; only map entries need sorting
+	if len(n.children) > 0 {
+		slices.SortFunc(entries, func(a, b childEntry) int { return int(a.ch - b.ch) })
+	}
+	return entries
+}
+
+// childEntry is a (rune, child) pair produced by sortedChildren.
+type childEntry struct {
+	ch   rune
+	node *Node
+}
+
+// Option configures a Trie created by NewTrie.
+type Option func(*Trie)
+
+// WithASCIIChildren makes every node store its ASCII children (runes 0..127) in a
+// fixed 128-element array instead of a map. Lookups become a single index operation
+// and map overhead disappears; non-ASCII runes still fall back to a per-node map.
+//
+// Best suited for ASCII-heavy keys (identifiers, URLs, English words).
+func WithASCIIChildren() Option {
+	return func(t *Trie) {
+		t.slots = asciiSlots
+	}
+}
+
+// WithLowercaseChildren makes every node store the children 'a'..'z' in a fixed
+// 26-element array instead of a map. Other runes fall back to a per-node map.
+//
+// This is the most compact layout for large lowercase English dictionaries.
+func WithLowercaseChildren() Option {
+	return func(t *Trie) {
+		t.slots = lowercaseSlots
+	}
+}
+
+// WithSuffixIndex makes the Trie maintain an internal Trie of its words reversed, so that
+// EndsWith and GetWordsWithSuffix run in time proportional to the suffix and the matches
+// instead of scanning every word. It roughly doubles the memory and the cost of updates.
+func WithSuffixIndex() Option {
+	return func(t *Trie) {
+		t.reverse = &Trie{}
+	}
 }
 
 // Trie represents a thread-safe Trie (prefix tree) implementation.
@@ -74,6 +273,11 @@ func NewTrieNode() *Node {
 //   - root: the root node of the Trie
 //   - size: the number of complete words stored in the Trie
 //   - mutex: a read-write mutex (RWMutex) to ensure concurrent safety
+//   - slots: size of the compact child array of each node (0 means map-only nodes)
+//   - gen: the generation of this Trie; only nodes of the same generation are modified in place
+//   - expiry / deadlines / now: bookkeeping for words inserted with InsertWithTTL
+//   - reverse: an internal Trie of the reversed words, maintained when WithSuffixIndex is used
+//   - weights: per-word weights used to rank SuggestCorrections
 //
 // Operations supported:
 //   - Insert: Add a word to the Trie
@@ -86,35 +290,154 @@ type Trie struct {
 	root  *Node
 	size  int
 	mutex sync.RWMutex
+	slots int
+	gen   uint64
+
+	expiry    map[string]time.Time                // deadlines of words inserted with a TTL
+	deadlines *priorityqueue.BinaryHeap[deadline] // expiry order of the words in expiry
+	now       func() time.Time                    // clock used for expiry, time.Now by default
+
+	reverse *Trie              // reversed words for suffix queries, nil unless WithSuffixIndex is used
+	weights map[string]float64 // ranking weights set by InsertWithWeight
 }
 
-// NewTrie creates and returns an empty Trie instance.
+// generations hands out unique Trie generations, so that a Trie never mistakes a
+// node shared with one of its snapshots for one it owns.
+var generations atomic.Uint64
+
+// NewTrie creates and returns an empty Trie instance configured by the given options.
 //
 // Example:
 //
 //	t := NewTrie()
 //	t.Insert("hello")
 //	fmt.Println(t.Search("hello")) // true
-func NewTrie() *Trie {
-	return &Trie{NewTrieNode(), 0, sync.RWMutex{}}
+//
+//	// compact node layout for lowercase dictionaries
+//	d := NewTrie(WithLowercaseChildren())
+func NewTrie(opts ...Option) *Trie {
+	t := &Trie{now: time.Now}
+	for _, opt := range opts {
+		opt(t)
+	}
+	t.gen = generations.Add(1)
+	t.root = t.newNode()
+	if t.reverse != nil {
+		t.reverse = NewTrie(func(r *Trie) { r.slots = t.slots })
+	}
+	return t
+}
+
+// newNode creates a node using the Trie's configured child layout.
+func (t *Trie) newNode() *Node {
+	if t.slots == 0 {
+		return &Node{children: make(map[rune]*Node), gen: t.gen}
+	}
+	// the child array is allocated lazily by setChild
+	return &Node{gen: t.gen}
 }
 
-// Size returns the total number of complete words stored in the Trie.
+// owned returns a version of n that t may modify in place: n itself if t owns it,
+// otherwise a shallow copy of n owned by t. The caller must link the copy into its parent.
 //
-// Time Complexity: O(1)
+// Time Complexity: O(C), where C = number of children of n, when a copy is made
+func (t *Trie) owned(n *Node) *Node {
+	if n.gen == t.gen {
+		return n
+	}
+	return &Node{
+		children: maps.Clone(n.children),
+		slots:    slices.Clone(n.slots),
+		isEnd:    n.isEnd,
+		count:    n.count,
+		gen:      t.gen,
+	}
+}
+
+// mutableRoot returns the root of the Trie, copying it first if it is shared with a snapshot.
+func (t *Trie) mutableRoot() *Node {
+	t.root = t.owned(t.root)
+	return t.root
+}
+
+// mutableChild returns the child of n reached through ch, ready to be modified in place,
+// or nil if there is no such child. n must already be owned by t (see mutableRoot).
+//
+// Time Complexity: O(1), plus the cost of copying a shared child
+func (t *Trie) mutableChild(n *Node, ch rune) *Node {
+	c := n.child(ch)
+	if c == nil || c.gen == t.gen {
+		return c
+	}
+	c = t.owned(c)
+	n.setChild(ch, c, t.slots)
+	return c
+}
+
+// Snapshot returns a frozen, point-in-time copy of the Trie in O(1).
+//
+// The snapshot and the Trie share all nodes; neither is copied up front. Afterwards,
+// every modification of either one copies only the nodes on the path it changes
+// (copy-on-write), so readers of a snapshot never observe later updates and never
+// contend for the original Trie's lock. This allows serving queries from a stable
+// version while the live Trie is rebuilt or updated in the background.
+//
+// The snapshot is a regular *Trie: it supports every query and may even be modified
+// without affecting the original.
+//
+// Example:
+//
+//	frozen := t.Snapshot()
+//	go func() { t.Insert("gopher") }() // does not affect frozen
+//	frozen.GetWordsWithPrefix("go")    // served without waiting for the writer
+//
+// Time Complexity: O(1), plus O(E log E) for E words inserted with a TTL and O(W) for
+// W words inserted with a weight
+func (t *Trie) Snapshot() *Trie {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.snapshot()
+}
+
+// snapshot implements Snapshot. The caller must hold the write lock.
+func (t *Trie) snapshot() *Trie {
+	snap := &Trie{
+		root:  t.root,
+		size:  t.size,
+		slots: t.slots,
+		gen:   generations.Add(1),
+		now:   t.now,
+	}
+	// words with a TTL keep expiring in the snapshot
+	for word, at := range t.expiry {
+		snap.setExpiry(word, at)
+	}
+	if t.reverse != nil {
+		snap.reverse = t.reverse.snapshot()
+	}
+	snap.weights = maps.Clone(t.weights)
+	// from now on t no longer owns its nodes; they belong to the shared history
+	t.gen = generations.Add(1)
+	return snap
+}
+
+// Size returns the total number of complete words stored in the Trie, not counting
+// expired words.
+//
+// Time Complexity: O(1), or O(E) while words with a TTL are stored
 func (t *Trie) Size() int {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
-	return t.size
+	return t.size - t.expiredWithPrefix("")
 }
 
 // IsEmpty returns true if the Trie contains no words, false otherwise.
 //
-// Time Complexity: O(1)
+// Time Complexity: O(1), or O(E) while words with a TTL are stored
 func (t *Trie) IsEmpty() bool {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
-	return t.size == 0
+	return t.size == t.expiredWithPrefix("")
 }
 
 // Insert adds a word into the Trie.
@@ -139,16 +462,39 @@ func (t *Trie) Insert(word string) {
 	}
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
-	current := t.root
+	t.purgeExpired()
+	// a plain insert makes a word inserted with a TTL permanent
+	delete(t.expiry, word)
+	t.insert(word)
+}
+
+// insert adds a non-empty word into the Trie. The caller must hold the write lock.
+//
+// Time Complexity: O(N), where N = length of the word
+func (t *Trie) insert(word string) {
+	current := t.mutableRoot()
 	for _, ch := range word {
-		if current.children[ch] == nil {
-			current.children[ch] = NewTrieNode()
+		next := t.mutableChild(current, ch)
+		if next == nil {
+			next = t.newNode()
+			current.setChild(ch, next, t.slots)
 		}
-		current = current.children[ch]
+		current = next
 	}
-	if !current.isEnd {
-		current.isEnd = true
-		t.size++
+	if current.isEnd {
+		return
+	}
+	current.isEnd = true
+	t.size++
+	// a new word was added, so every node on its path gains one descendant word
+	current = t.root
+	current.count++
+	for _, ch := range word {
+		current = current.child(ch)
+		current.count++
+	}
+	if t.reverse != nil {
+		t.reverse.insert(reverseRunes(word))
 	}
 }
 
@@ -172,12 +518,13 @@ func (t *Trie) Search(word string) bool {
 	defer t.mutex.RUnlock()
 	current := t.root
 	for _, ch := range word {
-		if current.children[ch] == nil {
+		next := current.child(ch)
+		if next == nil {
 			return false
 		}
-		current = current.children[ch]
+		current = next
 	}
-	return current.isEnd
+	return current.isEnd && !t.expired(word)
 }
 
 // StartsWith checks if there is any word in the Trie that starts with the given prefix.
@@ -199,12 +546,13 @@ func (t *Trie) StartsWith(prefix string) bool {
 	defer t.mutex.RUnlock()
 	current := t.root
 	for _, ch := range prefix {
-		if current.children[ch] == nil {
+		next := current.child(ch)
+		if next == nil {
 			return false
 		}
-		current = current.children[ch]
+		current = next
 	}
-	return true
+	return current.count > t.expiredWithPrefix(prefix)
 }
 
 // Dfs performs a depth-first search starting from the given node
@@ -222,10 +570,10 @@ func (t *Trie) dfs(node *Node, prefix string) []string {
 	var result []string
 	var dfs func(node *Node, prefix string)
 	dfs = func(node *Node, prefix string) {
-		if node.isEnd {
+		if node.isEnd && t.live(prefix) {
 			result = append(result, prefix)
 		}
-		for ch, child := range node.children {
+		for ch, child := range node.all() {
 			dfs(child, prefix+string(ch))
 		}
 	}
@@ -240,10 +588,11 @@ func (t *Trie) dfs(node *Node, prefix string) []string {
 func (t *Trie) findNodeForPrefix(prefix string) *Node {
 	current := t.root
 	for _, ch := range prefix {
-		if current.children[ch] == nil {
+		next := current.child(ch)
+		if next == nil {
 			return nil
 		}
-		current = current.children[ch]
+		current = next
 	}
 	return current
 }
@@ -277,16 +626,189 @@ func (t *Trie) GetWordsWithPrefix(prefix string) []string {
 	return t.dfs(current, prefix)
 }
 
+// CountWordsWithPrefix returns the number of words in the Trie that start with the given prefix.
+//
+// Each node stores the number of words in its subtree, so no traversal below the
+// prefix node and no result slice are needed; expired words that have not been
+// removed yet are subtracted.
+//
+// Time Complexity: O(K), or O(K + E) while words with a TTL are stored
+func (t *Trie) CountWordsWithPrefix(prefix string) int {
+	if len(prefix) == 0 {
+		return 0
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	current := t.findNodeForPrefix(prefix)
+	if current == nil {
+		return 0
+	}
+	return current.count - t.expiredWithPrefix(prefix)
+}
+
+// RemovePrefix deletes every word that starts with the given prefix in a single
+// operation and returns how many words were removed.
+//
+// Algorithm Steps:
+//   - Find the prefix node; its word count is the number of words to remove.
+//   - Traverse the prefix and push (node, char) pairs into a stack for backtracking.
+//   - Detach the prefix node together with its whole subtree.
+//   - Backtrack, decrementing ancestor counts and pruning ancestors left without words.
+//
+// Time Complexity: O(K), where K = length of the prefix; the detached subtree is
+// reclaimed by the garbage collector.
+func (t *Trie) RemovePrefix(prefix string) int {
+	if len(prefix) == 0 {
+		return 0
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.purgeExpired()
+	node := t.findNodeForPrefix(prefix)
+	if node == nil || node.count == 0 {
+		return 0
+	}
+	removed := node.count
+	var words []string
+	if t.reverse != nil {
+		words = t.dfs(node, prefix)
+	}
+	current := t.mutableRoot()
+	type Pair struct {
+		node *Node
+		ch   rune
+	}
+
+	s := stack.NewStack[Pair]()
+	for _, ch := range prefix {
+		_, _ = s.Push(Pair{current, ch})
+		current = t.mutableChild(current, ch)
+	}
+
+	for !s.IsEmpty() {
+		val, _ := s.Pop()
+		parent := val.node
+		child := parent.child(val.ch)
+		child.count -= removed
+		if child.count == 0 {
+			parent.deleteChild(val.ch)
+		}
+	}
+	t.root.count -= removed
+	t.size -= removed
+	for word := range t.expiry {
+		if strings.HasPrefix(word, prefix) {
+			delete(t.expiry, word)
+		}
+	}
+	for word := range t.weights {
+		if strings.HasPrefix(word, prefix) {
+			delete(t.weights, word)
+		}
+	}
+	for _, word := range words {
+		t.reverse.remove(reverseRunes(word))
+	}
+	return removed
+}
+
+// GetWordsWithPrefixSorted retrieves all words that start with the given prefix in
+// lexicographic rune order.
+//
+// Unlike GetWordsWithPrefix, whose order depends on map iteration, the result is
+// deterministic, which makes it suitable for UIs and snapshot tests without a post-sort.
+//
+// Time Complexity: O(K + V * C log C + M * L)
+//   - K = length of prefix, V = visited nodes, C = children per node
+//   - M = number of matching words, L = average length of matching words
+func (t *Trie) GetWordsWithPrefixSorted(prefix string) []string {
+	if len(prefix) == 0 {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	var result []string
+	current := t.findNodeForPrefix(prefix)
+	if current == nil {
+		return result
+	}
+	var dfs func(node *Node, path []rune)
+	dfs = func(node *Node, path []rune) {
+		if node.isEnd {
+			if word := string(path); t.live(word) {
+				result = append(result, word)
+			}
+		}
+		for _, e := range node.sortedChildren() {
+			dfs(e.node, append(path, e.ch))
+		}
+	}
+	dfs(current, []rune(prefix))
+	return result
+}
+
+// GetWordsWithPrefixN returns at most limit words that start with prefix, in
+// lexicographic order, skipping every word up to and including afterWord.
+//
+// It supports paging through completions: pass an empty afterWord for the first
+// page and the last word of the previous page to fetch the next one. Subtrees that
+// sort entirely before afterWord are skipped without being visited, and the traversal
+// stops as soon as limit words have been collected.
+//
+// Example:
+//
+//	page := t.GetWordsWithPrefixN("app", 10, "")
+//	next := t.GetWordsWithPrefixN("app", 10, page[len(page)-1])
+//
+// Time Complexity: O(K + D * C log C + limit * L)
+//   - K = length of prefix, D = depth of the skipped path, C = children per node
+//   - L = average length of returned words
+func (t *Trie) GetWordsWithPrefixN(prefix string, limit int, afterWord string) []string {
+	if len(prefix) == 0 || limit <= 0 {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	var result []string
+	current := t.findNodeForPrefix(prefix)
+	if current == nil {
+		return result
+	}
+	var dfs func(node *Node, path []rune) bool
+	dfs = func(node *Node, path []rune) bool {
+		p := string(path)
+		// every word below p sorts before afterWord unless p is a prefix of it
+		if p < afterWord && !strings.HasPrefix(afterWord, p) {
+			return true
+		}
+		if node.isEnd && p > afterWord && t.live(p) {
+			result = append(result, p)
+			if len(result) == limit {
+				return false
+			}
+		}
+		for _, e := range node.sortedChildren() {
+			if !dfs(e.node, append(path, e.ch)) {
+				return false
+			}
+		}
+		return true
+	}
+	dfs(current, []rune(prefix))
+	return result
+}
+
 // Remove deletes a word from the Trie if it exists.
 //
 // Returns true if the word was successfully removed, false otherwise.
 // It also removes unnecessary nodes to keep the Trie compact.
 //
 // Algorithm Steps:
-//   - Traverse the word and push (node, char) pairs into a stack for backtracking.
 //   - If the word does not exist or is not marked as the end, return false.
+//   - Traverse the word and push (node, char) pairs into a stack for backtracking.
 //   - Mark the last node as not the end.
-//   - Backtrack and remove nodes that are no longer needed (no children and not end).
+//   - Backtrack, decrementing the word count of every node on the path.
+//   - Remove nodes that are no longer needed (no words left in their subtree).
 //   - Decrement size and return true.
 //
 // Time Complexity: O(N), where N = length of the word
@@ -298,7 +820,18 @@ func (t *Trie) Remove(word string) bool {
 	}
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
-	current := t.root
+	t.purgeExpired()
+	return t.remove(word)
+}
+
+// remove deletes a word from the Trie if it exists. The caller must hold the write lock.
+//
+// Time Complexity: O(N), where N = length of the word
+func (t *Trie) remove(word string) bool {
+	if node := t.findNodeForPrefix(word); node == nil || !node.isEnd {
+		return false
+	}
+	current := t.mutableRoot()
 	type Pair struct {
 		node *Node
 		ch   rune
@@ -306,29 +839,503 @@ func (t *Trie) Remove(word string) bool {
 
 	s := stack.NewStack[Pair]()
 	for _, ch := range word {
-		next := current.children[ch]
-		if next == nil {
-			return false
-		}
 		_, _ = s.Push(Pair{current, ch})
-		current = next
-	}
-	if !current.isEnd {
-		return false
+		current = t.mutableChild(current, ch)
 	}
 	current.isEnd = false
 
+	// every node on the path loses one descendant word; nodes left
+	// without any words below them are pruned
 	for !s.IsEmpty() {
 		val, _ := s.Pop()
 		parent := val.node
 		ch := val.ch
-		child := parent.children[ch]
-		if len(child.children) == 0 && !child.isEnd {
-			delete(parent.children, ch)
-		} else {
-			break
+		child := parent.child(ch)
+		child.count--
+		if child.count == 0 {
+			parent.deleteChild(ch)
 		}
 	}
+	t.root.count--
 	t.size--
+	delete(t.expiry, word)
+	delete(t.weights, word)
+	if t.reverse != nil {
+		t.reverse.remove(reverseRunes(word))
+	}
+	return true
+}
+
+// Segment splits s into a sequence of words stored in the Trie (word break).
+//
+// Among all possible segmentations, the one with the fewest words is returned; ties are
+// broken in favour of longer words at the front. For example, with the words "pen",
+// "pine", "apple", "pineapple" and "penapple", Segment("pineapplepenapple") returns
+// ["pineapple", "penapple"].
+//
+// Returns:
+//   - the words of the segmentation and true if s can be segmented
+//   - nil and false if s cannot be segmented or is empty
+//
+// Algorithm Steps:
+//   - Process the start positions i of s from right to left.
+//   - From each i, walk the Trie along s[i:]; every terminal node reached at j marks a
+//     candidate word s[i:j].
+//   - best[i] = 1 + min(best[j]) over all candidates with a segmentable remainder s[j:].
+//   - Rebuild the segmentation by following the recorded split points from the start.
+//
+// A single walk from each position replaces the separate dictionary lookup per
+// substring that a hash-set based word break needs.
+//
+// Time Complexity: O(N * L), where N = length of s and L = length of the longest word
+//
+// Space Complexity: O(N)
+func (t *Trie) Segment(s string) ([]string, bool) {
+	if len(s) == 0 {
+		return nil, false
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	runes := []rune(s)
+	n := len(runes)
+	// best[i] is the fewest words needed for runes[i:] (-1 if impossible),
+	// split[i] the end of the first word of that segmentation
+	best := make([]int, n+1)
+	split := make([]int, n+1)
+	for i := range n {
+		best[i] = -1
+	}
+	for i := n - 1; i >= 0; i-- {
+		current := t.root
+		for j := i; j < n; j++ {
+			current = current.child(runes[j])
+			if current == nil {
+				break
+			}
+			if !current.isEnd || best[j+1] < 0 {
+				continue
+			}
+			if len(t.expiry) > 0 && t.expired(string(runes[i:j+1])) {
+				continue
+			}
+			if best[i] < 0 || best[j+1]+1 <= best[i] {
+				best[i] = best[j+1] + 1
+				split[i] = j + 1
+			}
+		}
+	}
+	if best[0] < 0 {
+		return nil, false
+	}
+	words := make([]string, 0, best[0])
+	for i := 0; i < n; i = split[i] {
+		words = append(words, string(runes[i:split[i]]))
+	}
+	return words, true
+}
+
+// SearchFuzzy returns all words in the Trie whose Levenshtein (edit) distance to the
+// given word is at most maxDistance. Expired words are skipped. The order of the
+// results is not guaranteed.
+//
+// Algorithm Steps:
+//   - Walk the Trie depth-first, maintaining one row of the Levenshtein DP table per node.
+//   - The row for a child is derived from its parent's row and the child's character.
+//   - If the last cell of a row is within maxDistance and the node is terminal, record the word.
+//   - Prune a branch as soon as the minimum value of its row exceeds maxDistance.
+//
+// Time Complexity: O(V * N) in the worst case, where V = number of visited nodes and
+// N = length of the word; pruning keeps V small for tight distance bounds.
+func (t *Trie) SearchFuzzy(word string, maxDistance int) []string {
+	if len(word) == 0 || maxDistance < 0 {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	var result []string
+	t.fuzzy([]rune(word), maxDistance, func(match string, _ int) {
+		if len(t.expiry) > 0 && t.expired(match) {
+			return
+		}
+		result = append(result, match)
+	})
+	return result
+}
+
+// fuzzy calls visit for every word within maxDistance edits of target, together with
+// its edit distance. The caller must hold the lock.
+//
+// Time Complexity: O(V * N), where V = number of visited nodes and N = length of target
+func (t *Trie) fuzzy(target []rune, maxDistance int, visit func(word string, distance int)) {
+	firstRow := make([]int, len(target)+1)
+	for i := range firstRow {
+		firstRow[i] = i
+	}
+	var dfs func(node *Node, ch rune, prefix []rune, prevRow []int)
+	dfs = func(node *Node, ch rune, prefix []rune, prevRow []int) {
+		row := make([]int, len(prevRow))
+		row[0] = prevRow[0] + 1
+		rowMin := row[0]
+		for i := 1; i < len(row); i++ {
+			cost := 1
+			if target[i-1] == ch {
+				cost = 0
+			}
+			row[i] = min(row[i-1]+1, prevRow[i]+1, prevRow[i-1]+cost)
+			rowMin = min(rowMin, row[i])
+		}
+		if node.isEnd && row[len(row)-1] <= maxDistance {
+			visit(string(prefix), row[len(row)-1])
+		}
+		if rowMin > maxDistance {
+			return
+		}
+		for next, child := range node.all() {
+			dfs(child, next, append(prefix, next), row)
+		}
+	}
+	for ch, child := range t.root.all() {
+		dfs(child, ch, []rune{ch}, firstRow)
+	}
+}
+
+// InsertBytes adds a word given as a byte slice into the Trie.
+//
+// The key is decoded as UTF-8 exactly like the string methods (invalid sequences
+// decode to utf8.RuneError), so InsertBytes([]byte("go")) and Insert("go") address
+// the same entry. No intermediate string is allocated.
+//
+// Time Complexity: O(N), where N = length of the key
+func (t *Trie) InsertBytes(key []byte) {
+	if len(key) == 0 {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.purgeExpired()
+	if len(t.expiry) > 0 {
+		delete(t.expiry, string(key))
+	}
+	current := t.mutableRoot()
+	// ranging over string(key) does not copy the slice
+	for _, ch := range string(key) {
+		next := t.mutableChild(current, ch)
+		if next == nil {
+			next = t.newNode()
+			current.setChild(ch, next, t.slots)
+		}
+		current = next
+	}
+	if current.isEnd {
+		return
+	}
+	current.isEnd = true
+	t.size++
+	current = t.root
+	current.count++
+	for _, ch := range string(key) {
+		current = current.child(ch)
+		current.count++
+	}
+	if t.reverse != nil {
+		t.reverse.insert(reverseRunes(string(key)))
+	}
+}
+
+// findNodeForPrefixBytes is the byte-slice counterpart of findNodeForPrefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (t *Trie) findNodeForPrefixBytes(prefix []byte) *Node {
+	current := t.root
+	for _, ch := range string(prefix) {
+		next := current.child(ch)
+		if next == nil {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// SearchBytes checks if a complete word, given as a byte slice, exists in the Trie.
+//
+// Time Complexity: O(N), where N = length of the key
+func (t *Trie) SearchBytes(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	node := t.findNodeForPrefixBytes(key)
+	return node != nil && node.isEnd && !t.expired(string(key))
+}
+
+// StartsWithBytes checks if there is any word in the Trie that starts with the given
+// byte-slice prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (t *Trie) StartsWithBytes(prefix []byte) bool {
+	if len(prefix) == 0 {
+		return false
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	node := t.findNodeForPrefixBytes(prefix)
+	return node != nil && node.count > t.expiredWithPrefix(string(prefix))
+}
+
+// GetWordsWithPrefixBytes retrieves all words in the Trie that start with the given
+// byte-slice prefix.
+//
+// Time Complexity: O(K + M * L)
+func (t *Trie) GetWordsWithPrefixBytes(prefix []byte) []string {
+	if len(prefix) == 0 {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	current := t.findNodeForPrefixBytes(prefix)
+	if current == nil {
+		return nil
+	}
+	return t.dfs(current, string(prefix))
+}
+
+// RemoveBytes deletes a word, given as a byte slice, from the Trie if it exists.
+//
+// Returns true if the word was successfully removed, false otherwise.
+//
+// Time Complexity: O(N), where N = length of the key
+func (t *Trie) RemoveBytes(key []byte) bool {
+	return t.Remove(string(key))
+}
+
+// walk lazily visits every word below node in depth-first order, calling yield for each.
+// It returns false as soon as yield asks to stop.
+//
+// Time Complexity: O(M * L) for a full traversal
+func (t *Trie) walk(node *Node, prefix []rune, yield func(string) bool) bool {
+	if node.isEnd {
+		if word := string(prefix); t.live(word) && !yield(word) {
+			return false
+		}
+	}
+	for ch, child := range node.all() {
+		if !t.walk(child, append(prefix, ch), yield) {
+			return false
+		}
+	}
 	return true
 }
+
+// Words returns an iterator over all words stored in the Trie.
+// The order of the words is not guaranteed.
+//
+// Words are produced lazily, so breaking out of the loop early avoids visiting the
+// rest of the Trie. The read lock is held for the duration of the iteration; the
+// loop body must not modify the Trie.
+//
+// Example:
+//
+//	for w := range t.Words() {
+//	    fmt.Println(w)
+//	}
+func (t *Trie) Words() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		t.mutex.RLock()
+		defer t.mutex.RUnlock()
+		t.walk(t.root, nil, yield)
+	}
+}
+
+// WordsWithPrefix returns an iterator over all words that start with the given prefix.
+// It is the lazy counterpart of GetWordsWithPrefix: callers can stop after the first
+// N results without materializing the entire result set.
+//
+// The read lock is held for the duration of the iteration; the loop body must not
+// modify the Trie.
+//
+// Example:
+//
+//	n := 0
+//	for w := range t.WordsWithPrefix("app") {
+//	    fmt.Println(w)
+//	    if n++; n == 10 {
+//	        break
+//	    }
+//	}
+func (t *Trie) WordsWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if len(prefix) == 0 {
+			return
+		}
+		t.mutex.RLock()
+		defer t.mutex.RUnlock()
+		current := t.findNodeForPrefix(prefix)
+		if current == nil {
+			return
+		}
+		t.walk(current, []rune(prefix), yield)
+	}
+}
+
+// Approximate memory costs used by Stats to estimate the Trie's footprint.
+const (
+	mapHeaderBytes = 48 // runtime map header
+	mapEntryBytes  = 16 // rune key + *Node value, ignoring bucket slack
+)
+
+// Stats describes the shape and approximate memory footprint of a Trie.
+//
+// Fields:
+//   - Nodes: number of nodes, including the root
+//   - Words: number of complete words stored
+//   - MaxDepth: length in runes of the longest stored path
+//   - MemoryBytes: estimated heap usage of all nodes, child arrays and maps
+type Stats struct {
+	Nodes       int
+	Words       int
+	MaxDepth    int
+	MemoryBytes int
+}
+
+// Stats walks the Trie and reports node count, word count, maximum depth and an
+// estimated memory footprint, for capacity planning and regression tracking.
+//
+// The memory figure is an estimate: it counts node structs, child arrays and map
+// entries, but not allocator rounding or map bucket slack.
+//
+// Time Complexity: O(V), where V = number of nodes
+func (t *Trie) Stats() Stats {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	st := Stats{Words: t.size}
+	nodeBytes := int(unsafe.Sizeof(Node{}))
+	ptrBytes := int(unsafe.Sizeof(uintptr(0)))
+	var visit func(node *Node, depth int)
+	visit = func(node *Node, depth int) {
+		st.Nodes++
+		st.MaxDepth = max(st.MaxDepth, depth)
+		st.MemoryBytes += nodeBytes + len(node.slots)*ptrBytes
+		if node.children != nil {
+			st.MemoryBytes += mapHeaderBytes + len(node.children)*mapEntryBytes
+		}
+		for _, child := range node.all() {
+			visit(child, depth+1)
+		}
+	}
+	visit(t.root, 0)
+	return st
+}
+
+// LongestCommonPrefix returns the longest prefix shared by every word in the Trie.
+//
+// Algorithm Steps:
+//   - Start from the root.
+//   - While the current node is not terminal and has exactly one child, descend into it.
+//   - The runes collected along the way form the common prefix.
+//
+// Returns an empty string if the Trie is empty or the words share no prefix.
+//
+// Time Complexity: O(L), where L = length of the common prefix
+func (t *Trie) LongestCommonPrefix() string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.size == 0 {
+		return ""
+	}
+	var prefix []rune
+	current := t.root
+	for !current.isEnd && current.numChildren() == 1 {
+		for ch, child := range current.all() {
+			prefix = append(prefix, ch)
+			current = child
+		}
+	}
+	return string(prefix)
+}
+
+// reverseRunes returns s with its runes in reverse order.
+//
+// Time Complexity: O(N), where N = length of s
+func reverseRunes(s string) string {
+	runes := []rune(s)
+	slices.Reverse(runes)
+	return string(runes)
+}
+
+// EndsWith checks if there is any word in the Trie that ends with the given suffix.
+//
+// With WithSuffixIndex the lookup walks the reversed Trie; otherwise every word is
+// scanned until a match is found.
+//
+// Time Complexity: O(K) with a suffix index, O(M * L) otherwise
+//   - K = length of suffix, M = number of words, L = average word length
+func (t *Trie) EndsWith(suffix string) bool {
+	if len(suffix) == 0 {
+		return false
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.reverse != nil {
+		rev := reverseRunes(suffix)
+		node := t.reverse.findNodeForPrefix(rev)
+		if node == nil || len(t.expiry) == 0 {
+			return node != nil
+		}
+		found := false
+		t.reverse.walk(node, []rune(rev), func(word string) bool {
+			found = t.live(reverseRunes(word))
+			return !found
+		})
+		return found
+	}
+	found := false
+	t.walk(t.root, nil, func(word string) bool {
+		found = strings.HasSuffix(word, suffix)
+		return !found
+	})
+	return found
+}
+
+// GetWordsWithSuffix retrieves all words in the Trie that end with the given suffix.
+// The order of the results is not guaranteed.
+//
+// Algorithm Steps (with WithSuffixIndex):
+//   - Find the node of the reversed suffix in the reversed Trie.
+//   - Collect the reversed words below it with DFS.
+//   - Reverse every collected word back.
+//
+// Without a suffix index every word is scanned.
+//
+// Time Complexity: O(K + S * L) with a suffix index, O(M * L) otherwise
+//   - K = length of suffix, S = number of matching words
+//   - M = number of words, L = average word length
+func (t *Trie) GetWordsWithSuffix(suffix string) []string {
+	if len(suffix) == 0 {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	var result []string
+	if t.reverse != nil {
+		rev := reverseRunes(suffix)
+		node := t.reverse.findNodeForPrefix(rev)
+		if node == nil {
+			return result
+		}
+		for _, word := range t.reverse.dfs(node, rev) {
+			if word = reverseRunes(word); t.live(word) {
+				result = append(result, word)
+			}
+		}
+		return result
+	}
+	t.walk(t.root, nil, func(word string) bool {
+		if strings.HasSuffix(word, suffix) {
+			result = append(result, word)
+		}
+		return true
+	})
+	return result
+}
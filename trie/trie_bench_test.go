@@ -170,3 +170,111 @@ func BenchmarkTrieMapPrefixSearchParallel(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkTrieSearchBytes(b *testing.B) {
+	t := NewTrie()
+	for _, word := range words {
+		t.Insert(word)
+	}
+	key := []byte("application")
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		t.SearchBytes(key)
+	}
+}
+
+func BenchmarkTrieInsertLargeLowercase(b *testing.B) {
+	dict := make([]string, 100000)
+	for i := range dict {
+		var sb strings.Builder
+		for n := i; ; n /= 26 {
+			sb.WriteByte(byte('a' + n%26))
+			if n < 26 {
+				break
+			}
+		}
+		dict[i] = sb.String()
+	}
+	layouts := map[string][]Option{
+		"map":       nil,
+		"lowercase": {WithLowercaseChildren()},
+	}
+	for name, opts := range layouts {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				t := NewTrie(opts...)
+				for _, w := range dict {
+					t.Insert(w)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkShardedTrieParallelInsert(b *testing.B) {
+	keys := make([]string, 1<<14)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%c%d", 'a'+rune(i%26), i)
+	}
+	b.Run("trie", func(b *testing.B) {
+		t := NewTrie()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				t.Insert(keys[i&(len(keys)-1)])
+				i++
+			}
+		})
+	})
+	b.Run("sharded", func(b *testing.B) {
+		st := NewShardedTrie(0)
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				st.Insert(keys[i&(len(keys)-1)])
+				i++
+			}
+		})
+	})
+}
+
+func BenchmarkTrieInsertAfterSnapshot(b *testing.B) {
+	t := NewTrie()
+	for _, w := range words {
+		t.Insert(w)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		t.Snapshot()
+		t.Insert("applesauce")
+		t.Remove("applesauce")
+	}
+}
+
+func BenchmarkDoubleArrayTrieSearch(b *testing.B) {
+	dict := make([]string, 100000)
+	for i := range dict {
+		dict[i] = fmt.Sprintf("word%d", i)
+	}
+	t := NewTrie()
+	for _, w := range dict {
+		t.Insert(w)
+	}
+	da := t.Compile()
+
+	b.Run("trie", func(b *testing.B) {
+		b.ReportMetric(float64(t.Stats().MemoryBytes), "mem-bytes")
+		for i := 0; i < b.N; i++ {
+			t.Search(dict[i%len(dict)])
+		}
+	})
+	b.Run("double-array", func(b *testing.B) {
+		b.ReportMetric(float64(da.MemoryBytes()), "mem-bytes")
+		for i := 0; i < b.N; i++ {
+			da.Search(dict[i%len(dict)])
+		}
+	})
+}
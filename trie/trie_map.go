@@ -0,0 +1,235 @@
+package trie
+
+import (
+	"sync"
+
+	"github.com/Zubayear/ryushin/stack"
+)
+
+// mapNode represents a single node in a TrieMap.
+//
+// Each node contains:
+//   - children: a map of rune to mapNode pointers representing possible next characters.
+//   - value: the payload attached to the key ending at this node.
+//   - isEnd: a boolean flag that indicates whether this node marks the end of a key.
+type mapNode[V any] struct {
+	children map[rune]*mapNode[V] // maps each character to its next node
+	value    V                    // payload stored for the key ending here
+	isEnd    bool                 // true if this node marks the end of a valid key
+}
+
+// newMapNode creates and returns a new TrieMap node with an empty children map.
+func newMapNode[V any]() *mapNode[V] {
+	return &mapNode[V]{children: make(map[rune]*mapNode[V])}
+}
+
+// Entry is a key/value pair stored in a TrieMap.
+type Entry[V any] struct {
+	Key   string
+	Value V
+}
+
+// TrieMap is a thread-safe, map-style Trie that attaches a value of type V to every key.
+//
+// It offers the same prefix-oriented operations as Trie, but removes the need to keep
+// a parallel map[string]V beside the trie to store payloads for each word.
+//
+// Fields:
+//   - root: the root node of the TrieMap
+//   - size: the number of keys stored in the TrieMap
+//   - mutex: a read-write mutex (RWMutex) to ensure concurrent safety
+//
+// Example:
+//
+//	tm := NewTrieMap[int]()
+//	tm.Insert("go", 1)
+//	tm.Insert("gopher", 2)
+//	v, ok := tm.Get("gopher") // 2, true
+type TrieMap[V any] struct {
+	root  *mapNode[V]
+	size  int
+	mutex sync.RWMutex
+}
+
+// NewTrieMap creates and returns an empty TrieMap instance.
+func NewTrieMap[V any]() *TrieMap[V] {
+	return &TrieMap[V]{root: newMapNode[V]()}
+}
+
+// Size returns the total number of keys stored in the TrieMap.
+//
+// Time Complexity: O(1)
+func (tm *TrieMap[V]) Size() int {
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+	return tm.size
+}
+
+// IsEmpty returns true if the TrieMap contains no keys, false otherwise.
+//
+// Time Complexity: O(1)
+func (tm *TrieMap[V]) IsEmpty() bool {
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+	return tm.size == 0
+}
+
+// Insert associates value with key. If the key already exists, its value is replaced
+// and the size does not change.
+//
+// Empty keys are ignored.
+//
+// Time Complexity: O(N), where N = length of the key
+func (tm *TrieMap[V]) Insert(key string, value V) {
+	if len(key) == 0 {
+		return
+	}
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	current := tm.root
+	for _, ch := range key {
+		if current.children[ch] == nil {
+			current.children[ch] = newMapNode[V]()
+		}
+		current = current.children[ch]
+	}
+	if !current.isEnd {
+		current.isEnd = true
+		tm.size++
+	}
+	current.value = value
+}
+
+// findNode returns the node corresponding to the last character of key,
+// or nil if the path does not exist.
+//
+// Time Complexity: O(K), where K = length of the key
+func (tm *TrieMap[V]) findNode(key string) *mapNode[V] {
+	current := tm.root
+	for _, ch := range key {
+		if current.children[ch] == nil {
+			return nil
+		}
+		current = current.children[ch]
+	}
+	return current
+}
+
+// Get returns the value stored for key and whether the key exists.
+//
+// Time Complexity: O(N), where N = length of the key
+func (tm *TrieMap[V]) Get(key string) (V, bool) {
+	var zero V
+	if len(key) == 0 {
+		return zero, false
+	}
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+	node := tm.findNode(key)
+	if node == nil || !node.isEnd {
+		return zero, false
+	}
+	return node.value, true
+}
+
+// Search checks if key exists in the TrieMap.
+//
+// Time Complexity: O(N), where N = length of the key
+func (tm *TrieMap[V]) Search(key string) bool {
+	_, ok := tm.Get(key)
+	return ok
+}
+
+// StartsWith checks if there is any key in the TrieMap that starts with the given prefix.
+//
+// Time Complexity: O(K), where K = length of the prefix
+func (tm *TrieMap[V]) StartsWith(prefix string) bool {
+	if len(prefix) == 0 {
+		return false
+	}
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+	return tm.findNode(prefix) != nil
+}
+
+// GetWordsWithPrefix retrieves all key/value pairs whose key starts with the given prefix.
+//
+// Returns:
+//   - A slice of entries whose keys start with the prefix (order not guaranteed)
+//   - An empty slice if the prefix does not exist
+//
+// Time Complexity: O(K + M * L)
+//   - K = length of prefix
+//   - M = number of matching keys
+//   - L = average length of matching keys
+func (tm *TrieMap[V]) GetWordsWithPrefix(prefix string) []Entry[V] {
+	if len(prefix) == 0 {
+		return nil
+	}
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+	var result []Entry[V]
+	current := tm.findNode(prefix)
+	if current == nil {
+		return result
+	}
+	var dfs func(node *mapNode[V], prefix string)
+	dfs = func(node *mapNode[V], prefix string) {
+		if node.isEnd {
+			result = append(result, Entry[V]{Key: prefix, Value: node.value})
+		}
+		for ch, child := range node.children {
+			dfs(child, prefix+string(ch))
+		}
+	}
+	dfs(current, prefix)
+	return result
+}
+
+// Remove deletes key and its value from the TrieMap if it exists.
+//
+// Returns true if the key was successfully removed, false otherwise.
+// It also removes unnecessary nodes to keep the TrieMap compact.
+//
+// Time Complexity: O(N), where N = length of the key
+func (tm *TrieMap[V]) Remove(key string) bool {
+	if len(key) == 0 {
+		return false
+	}
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	current := tm.root
+	type Pair struct {
+		node *mapNode[V]
+		ch   rune
+	}
+
+	s := stack.NewStack[Pair]()
+	for _, ch := range key {
+		next := current.children[ch]
+		if next == nil {
+			return false
+		}
+		_, _ = s.Push(Pair{current, ch})
+		current = next
+	}
+	if !current.isEnd {
+		return false
+	}
+	var zero V
+	current.isEnd = false
+	current.value = zero
+
+	for !s.IsEmpty() {
+		val, _ := s.Pop()
+		parent := val.node
+		child := parent.children[val.ch]
+		if len(child.children) == 0 && !child.isEnd {
+			delete(parent.children, val.ch)
+		} else {
+			break
+		}
+	}
+	tm.size--
+	return true
+}
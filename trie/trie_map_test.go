@@ -0,0 +1,109 @@
+package trie
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTrieMapInsertAndGet(t *testing.T) {
+	tm := NewTrieMap[int]()
+	if !tm.IsEmpty() {
+		t.Fatalf("expected empty TrieMap")
+	}
+
+	tm.Insert("go", 1)
+	tm.Insert("gopher", 2)
+	tm.Insert("golang", 3)
+	tm.Insert("", 4)
+
+	if tm.Size() != 3 {
+		t.Errorf("expected size 3, got %d", tm.Size())
+	}
+
+	tests := []struct {
+		key   string
+		value int
+		ok    bool
+	}{
+		{"go", 1, true},
+		{"gopher", 2, true},
+		{"golang", 3, true},
+		{"gop", 0, false},
+		{"java", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		v, ok := tm.Get(tt.key)
+		if v != tt.value || ok != tt.ok {
+			t.Errorf("Get(%q) = %v, %v; want %v, %v", tt.key, v, ok, tt.value, tt.ok)
+		}
+	}
+
+	tm.Insert("go", 10)
+	if v, _ := tm.Get("go"); v != 10 {
+		t.Errorf("expected updated value 10, got %d", v)
+	}
+	if tm.Size() != 3 {
+		t.Errorf("expected size 3 after update, got %d", tm.Size())
+	}
+	if !tm.StartsWith("gol") || tm.StartsWith("ja") {
+		t.Errorf("StartsWith returned unexpected result")
+	}
+	if !tm.Search("golang") || tm.Search("gol") {
+		t.Errorf("Search returned unexpected result")
+	}
+}
+
+func TestTrieMapGetWordsWithPrefix(t *testing.T) {
+	tm := NewTrieMap[string]()
+	tm.Insert("he", "pronoun")
+	tm.Insert("hello", "greeting")
+	tm.Insert("hero", "protagonist")
+	tm.Insert("world", "planet")
+
+	got := tm.GetWordsWithPrefix("he")
+	sort.Slice(got, func(i, j int) bool { return got[i].Key < got[j].Key })
+	expected := []Entry[string]{
+		{"he", "pronoun"},
+		{"hello", "greeting"},
+		{"hero", "protagonist"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GetWordsWithPrefix(he) = %v; want %v", got, expected)
+	}
+	if got := tm.GetWordsWithPrefix("x"); len(got) != 0 {
+		t.Errorf("expected no entries, got %v", got)
+	}
+	if got := tm.GetWordsWithPrefix(""); got != nil {
+		t.Errorf("expected nil for empty prefix, got %v", got)
+	}
+}
+
+func TestTrieMapRemove(t *testing.T) {
+	tm := NewTrieMap[int]()
+	tm.Insert("he", 1)
+	tm.Insert("hello", 2)
+
+	if tm.Remove("hel") {
+		t.Errorf("Remove(hel) = true; want false")
+	}
+	if !tm.Remove("hello") {
+		t.Errorf("Remove(hello) = false; want true")
+	}
+	if _, ok := tm.Get("hello"); ok {
+		t.Errorf("hello should be removed")
+	}
+	if v, ok := tm.Get("he"); !ok || v != 1 {
+		t.Errorf("he should still exist with value 1")
+	}
+	if tm.StartsWith("hel") {
+		t.Errorf("dangling nodes for hello should be pruned")
+	}
+	if tm.Remove("") || tm.Remove("missing") {
+		t.Errorf("Remove of missing key should return false")
+	}
+	if tm.Size() != 1 {
+		t.Errorf("expected size 1, got %d", tm.Size())
+	}
+}
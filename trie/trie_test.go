@@ -154,3 +154,452 @@ func TestTrieEmptyString(t *testing.T) {
 		t.Errorf("Expected %v, got %v\n", false, f)
 	}
 }
+
+func TestTrieSearchFuzzy(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"hello", "help", "hell", "shell", "world", "word"} {
+		tr.Insert(w)
+	}
+
+	tests := []struct {
+		word     string
+		distance int
+		expected []string
+	}{
+		{"hello", 0, []string{"hello"}},
+		{"helo", 1, []string{"hell", "hello", "help"}},
+		{"wrd", 1, []string{"word"}},
+		{"wrld", 2, []string{"word", "world"}},
+		{"xyz", 1, nil},
+		{"", 3, nil},
+	}
+	for _, tt := range tests {
+		got := tr.SearchFuzzy(tt.word, tt.distance)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("SearchFuzzy(%q, %d) = %v; want %v", tt.word, tt.distance, got, tt.expected)
+		}
+	}
+}
+
+func TestTrieBytesAPI(t *testing.T) {
+	tr := NewTrie()
+	tr.InsertBytes([]byte("hello"))
+	tr.InsertBytes([]byte("héllo"))
+	tr.InsertBytes(nil)
+	tr.Insert("help")
+
+	if tr.Size() != 3 {
+		t.Fatalf("expected size 3, got %d", tr.Size())
+	}
+	if !tr.Search("hello") || !tr.SearchBytes([]byte("help")) || !tr.SearchBytes([]byte("héllo")) {
+		t.Errorf("byte and string keys should address the same entries")
+	}
+	if tr.SearchBytes([]byte("hel")) || tr.SearchBytes(nil) {
+		t.Errorf("SearchBytes should not match prefixes or empty keys")
+	}
+	if !tr.StartsWithBytes([]byte("hé")) || tr.StartsWithBytes([]byte("x")) || tr.StartsWithBytes(nil) {
+		t.Errorf("StartsWithBytes returned unexpected result")
+	}
+
+	got := tr.GetWordsWithPrefixBytes([]byte("hel"))
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"hello", "help"}) {
+		t.Errorf("GetWordsWithPrefixBytes(hel) = %v", got)
+	}
+	if tr.GetWordsWithPrefixBytes([]byte("z")) != nil {
+		t.Errorf("expected nil for missing prefix")
+	}
+
+	if !tr.RemoveBytes([]byte("hello")) || tr.RemoveBytes([]byte("hello")) {
+		t.Errorf("RemoveBytes should remove once")
+	}
+	if tr.Size() != 2 {
+		t.Errorf("expected size 2, got %d", tr.Size())
+	}
+}
+
+func TestTrieWordsIterators(t *testing.T) {
+	tr := NewTrie()
+	words := []string{"he", "hello", "helium", "hero", "world"}
+	for _, w := range words {
+		tr.Insert(w)
+	}
+
+	var all []string
+	for w := range tr.Words() {
+		all = append(all, w)
+	}
+	sort.Strings(all)
+	sort.Strings(words)
+	if !reflect.DeepEqual(all, words) {
+		t.Errorf("Words() = %v; want %v", all, words)
+	}
+
+	var withPrefix []string
+	for w := range tr.WordsWithPrefix("hel") {
+		withPrefix = append(withPrefix, w)
+	}
+	sort.Strings(withPrefix)
+	if !reflect.DeepEqual(withPrefix, []string{"helium", "hello"}) {
+		t.Errorf("WordsWithPrefix(hel) = %v", withPrefix)
+	}
+
+	count := 0
+	for range tr.WordsWithPrefix("he") {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("expected early break after 2 words, got %d", count)
+	}
+
+	for w := range tr.WordsWithPrefix("x") {
+		t.Errorf("unexpected word %q for missing prefix", w)
+	}
+	for w := range tr.WordsWithPrefix("") {
+		t.Errorf("unexpected word %q for empty prefix", w)
+	}
+
+	// the lock is released after an early break
+	tr.Insert("heron")
+	if !tr.Search("heron") {
+		t.Errorf("expected insert to succeed after iteration")
+	}
+}
+
+func TestTrieCountWordsWithPrefix(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"he", "hello", "helium", "hero", "world"} {
+		tr.Insert(w)
+	}
+	tr.Insert("hello")
+	tr.InsertBytes([]byte("help"))
+
+	tests := []struct {
+		prefix   string
+		expected int
+	}{
+		{"h", 5},
+		{"he", 5},
+		{"hel", 3},
+		{"hello", 1},
+		{"w", 1},
+		{"x", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := tr.CountWordsWithPrefix(tt.prefix); got != tt.expected {
+			t.Errorf("CountWordsWithPrefix(%q) = %d; want %d", tt.prefix, got, tt.expected)
+		}
+	}
+
+	tr.Remove("he")
+	tr.Remove("hello")
+	tr.Remove("missing")
+	if got := tr.CountWordsWithPrefix("he"); got != 3 {
+		t.Errorf("CountWordsWithPrefix(he) after removals = %d; want 3", got)
+	}
+	if got := tr.CountWordsWithPrefix("hell"); got != 0 {
+		t.Errorf("CountWordsWithPrefix(hell) after removals = %d; want 0", got)
+	}
+	if tr.StartsWith("hell") {
+		t.Errorf("nodes of removed words should be pruned")
+	}
+}
+
+func TestTrieRemovePrefix(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"he", "hello", "helium", "hero", "world"} {
+		tr.Insert(w)
+	}
+
+	if n := tr.RemovePrefix("x"); n != 0 {
+		t.Errorf("RemovePrefix(x) = %d; want 0", n)
+	}
+	if n := tr.RemovePrefix(""); n != 0 {
+		t.Errorf("RemovePrefix('') = %d; want 0", n)
+	}
+	if n := tr.RemovePrefix("hel"); n != 2 {
+		t.Errorf("RemovePrefix(hel) = %d; want 2", n)
+	}
+	if tr.Size() != 3 || tr.StartsWith("hel") || !tr.Search("he") || !tr.Search("hero") {
+		t.Errorf("unexpected trie state after RemovePrefix(hel): size=%d", tr.Size())
+	}
+	if got := tr.CountWordsWithPrefix("h"); got != 2 {
+		t.Errorf("CountWordsWithPrefix(h) = %d; want 2", got)
+	}
+
+	if n := tr.RemovePrefix("h"); n != 2 {
+		t.Errorf("RemovePrefix(h) = %d; want 2", n)
+	}
+	if tr.Size() != 1 || tr.StartsWith("h") || !tr.Search("world") {
+		t.Errorf("unexpected trie state after RemovePrefix(h): size=%d", tr.Size())
+	}
+}
+
+func TestTrieCompactChildLayouts(t *testing.T) {
+	layouts := map[string][]Option{
+		"map":       nil,
+		"ascii":     {WithASCIIChildren()},
+		"lowercase": {WithLowercaseChildren()},
+	}
+	words := []string{"he", "hello", "helium", "Hero", "héllo", "a-b", "zebra"}
+	for name, opts := range layouts {
+		t.Run(name, func(t *testing.T) {
+			tr := NewTrie(opts...)
+			for _, w := range words {
+				tr.Insert(w)
+			}
+			if tr.Size() != len(words) {
+				t.Fatalf("expected size %d, got %d", len(words), tr.Size())
+			}
+			for _, w := range words {
+				if !tr.Search(w) {
+					t.Errorf("Search(%q) = false; want true", w)
+				}
+			}
+			if tr.Search("hel") || !tr.StartsWith("hé") || !tr.StartsWith("He") {
+				t.Errorf("unexpected prefix results")
+			}
+
+			got := tr.GetWordsWithPrefix("he")
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, []string{"he", "helium", "hello"}) {
+				t.Errorf("GetWordsWithPrefix(he) = %v", got)
+			}
+
+			if !tr.Remove("hello") || !tr.Remove("héllo") || tr.StartsWith("hell") || tr.StartsWith("hé") {
+				t.Errorf("Remove should prune nodes in every layout")
+			}
+			if n := tr.RemovePrefix("he"); n != 2 {
+				t.Errorf("RemovePrefix(he) = %d; want 2", n)
+			}
+			if tr.Size() != 3 {
+				t.Errorf("expected size 3, got %d", tr.Size())
+			}
+		})
+	}
+}
+
+func TestTrieStats(t *testing.T) {
+	tr := NewTrie()
+	st := tr.Stats()
+	if st.Nodes != 1 || st.Words != 0 || st.MaxDepth != 0 || st.MemoryBytes <= 0 {
+		t.Fatalf("unexpected stats for empty trie: %+v", st)
+	}
+
+	for _, w := range []string{"he", "hello", "hero"} {
+		tr.Insert(w)
+	}
+	st = tr.Stats()
+	// root + h,e + l,l,o + r,o
+	if st.Nodes != 8 || st.Words != 3 || st.MaxDepth != 5 {
+		t.Errorf("unexpected stats: %+v", st)
+	}
+
+	compact := NewTrie(WithLowercaseChildren())
+	for _, w := range []string{"he", "hello", "hero"} {
+		compact.Insert(w)
+	}
+	cst := compact.Stats()
+	if cst.Nodes != st.Nodes || cst.Words != st.Words || cst.MaxDepth != st.MaxDepth {
+		t.Errorf("layouts should report the same shape: %+v vs %+v", cst, st)
+	}
+}
+
+func TestTrieLongestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		words    []string
+		expected string
+	}{
+		{nil, ""},
+		{[]string{"flower"}, "flower"},
+		{[]string{"flower", "flow", "flight"}, "fl"},
+		{[]string{"interview", "internet", "interval"}, "inter"},
+		{[]string{"he", "hello"}, "he"},
+		{[]string{"dog", "cat"}, ""},
+	}
+	for _, tt := range tests {
+		tr := NewTrie(WithASCIIChildren())
+		for _, w := range tt.words {
+			tr.Insert(w)
+		}
+		if got := tr.LongestCommonPrefix(); got != tt.expected {
+			t.Errorf("LongestCommonPrefix(%v) = %q; want %q", tt.words, got, tt.expected)
+		}
+	}
+}
+
+func TestTrieGetWordsWithPrefixSorted(t *testing.T) {
+	words := []string{"hero", "he", "helium", "hello", "hElp", "héllo", "help"}
+	for _, opts := range [][]Option{nil, {WithLowercaseChildren()}, {WithASCIIChildren()}} {
+		tr := NewTrie(opts...)
+		for _, w := range words {
+			tr.Insert(w)
+		}
+		expected := []string{"hElp", "he", "helium", "hello", "help", "hero", "héllo"}
+		if got := tr.GetWordsWithPrefixSorted("h"); !reflect.DeepEqual(got, expected) {
+			t.Errorf("GetWordsWithPrefixSorted(h) = %v; want %v", got, expected)
+		}
+		if got := tr.GetWordsWithPrefixSorted("x"); len(got) != 0 {
+			t.Errorf("expected no matches, got %v", got)
+		}
+		if got := tr.GetWordsWithPrefixSorted(""); got != nil {
+			t.Errorf("expected nil for empty prefix, got %v", got)
+		}
+	}
+}
+
+func TestTrieGetWordsWithPrefixN(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"app", "apple", "application", "apply", "apt", "banana"} {
+		tr.Insert(w)
+	}
+
+	page := tr.GetWordsWithPrefixN("ap", 2, "")
+	if !reflect.DeepEqual(page, []string{"app", "apple"}) {
+		t.Fatalf("first page = %v", page)
+	}
+	page = tr.GetWordsWithPrefixN("ap", 2, page[len(page)-1])
+	if !reflect.DeepEqual(page, []string{"application", "apply"}) {
+		t.Fatalf("second page = %v", page)
+	}
+	page = tr.GetWordsWithPrefixN("ap", 2, page[len(page)-1])
+	if !reflect.DeepEqual(page, []string{"apt"}) {
+		t.Fatalf("third page = %v", page)
+	}
+	if page = tr.GetWordsWithPrefixN("ap", 2, "apt"); len(page) != 0 {
+		t.Errorf("expected empty last page, got %v", page)
+	}
+
+	// afterWord does not need to be stored in the trie
+	if got := tr.GetWordsWithPrefixN("ap", 10, "appk"); !reflect.DeepEqual(got, []string{"apple", "application", "apply", "apt"}) {
+		t.Errorf("GetWordsWithPrefixN after appk = %v", got)
+	}
+	if got := tr.GetWordsWithPrefixN("ap", 0, ""); got != nil {
+		t.Errorf("expected nil for zero limit, got %v", got)
+	}
+	if got := tr.GetWordsWithPrefixN("x", 5, ""); len(got) != 0 {
+		t.Errorf("expected no matches, got %v", got)
+	}
+}
+
+func TestTrieSnapshot(t *testing.T) {
+	for name, opts := range map[string][]Option{"map": nil, "lowercase": {WithLowercaseChildren()}} {
+		t.Run(name, func(t *testing.T) {
+			tr := NewTrie(opts...)
+			for _, w := range []string{"app", "apple", "apply", "bat"} {
+				tr.Insert(w)
+			}
+			snap := tr.Snapshot()
+
+			tr.Insert("apt")
+			tr.Remove("apple")
+			tr.RemovePrefix("ba")
+			if tr.Size() != 3 || snap.Size() != 4 {
+				t.Fatalf("sizes = %d, %d; want 3, 4", tr.Size(), snap.Size())
+			}
+			got := snap.GetWordsWithPrefixSorted("a")
+			if !reflect.DeepEqual(got, []string{"app", "apple", "apply"}) {
+				t.Errorf("snapshot words = %v", got)
+			}
+			if !snap.Search("bat") || snap.Search("apt") || snap.CountWordsWithPrefix("ap") != 3 {
+				t.Errorf("snapshot observed later updates")
+			}
+			got = tr.GetWordsWithPrefixSorted("a")
+			if !reflect.DeepEqual(got, []string{"app", "apply", "apt"}) {
+				t.Errorf("live words = %v", got)
+			}
+
+			// modifying the snapshot does not affect the live trie
+			snap.Insert("cat")
+			if tr.Search("cat") || !snap.Search("cat") {
+				t.Errorf("snapshot and trie are not independent")
+			}
+
+			// snapshots of snapshots keep their own view
+			again := tr.Snapshot()
+			tr.Insert("zoo")
+			if again.Search("zoo") || again.Size() != 3 {
+				t.Errorf("second snapshot observed later updates")
+			}
+		})
+	}
+}
+
+func TestTrieSegment(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"apple", "pen", "pine", "pineapple", "penapple", "a", "cat", "cats", "and", "sand", "dog", "日本", "語"} {
+		tr.Insert(w)
+	}
+
+	tests := []struct {
+		s        string
+		expected []string
+		ok       bool
+	}{
+		{"pineapplepenapple", []string{"pineapple", "penapple"}, true},
+		{"applepenapple", []string{"apple", "penapple"}, true},
+		{"catsanddog", []string{"cats", "and", "dog"}, true},
+		{"日本語", []string{"日本", "語"}, true},
+		{"aaa", []string{"a", "a", "a"}, true},
+		{"catsandog", nil, false},
+		{"pin", nil, false},
+		{"", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := tr.Segment(tt.s)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Segment(%q) = %v, %v; want %v, %v", tt.s, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestTrieSuffixQueries(t *testing.T) {
+	for name, opts := range map[string][]Option{
+		"scan":            nil,
+		"index":           {WithSuffixIndex()},
+		"index-lowercase": {WithSuffixIndex(), WithLowercaseChildren()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tr := NewTrie(opts...)
+			for _, w := range []string{"running", "jumping", "sing", "ring", "run", "日本語"} {
+				tr.Insert(w)
+			}
+			tr.InsertBytes([]byte("walking"))
+
+			got := tr.GetWordsWithSuffix("ing")
+			sort.Strings(got)
+			expected := []string{"jumping", "ring", "running", "sing", "walking"}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("GetWordsWithSuffix(ing) = %v; want %v", got, expected)
+			}
+			if !tr.EndsWith("本語") || !tr.EndsWith("un") || tr.EndsWith("xyz") || tr.EndsWith("") {
+				t.Errorf("EndsWith returned unexpected result")
+			}
+			if tr.GetWordsWithSuffix("") != nil || len(tr.GetWordsWithSuffix("zz")) != 0 {
+				t.Errorf("expected no matches for empty or unknown suffix")
+			}
+
+			tr.Remove("sing")
+			tr.RemovePrefix("ru")
+			snap := tr.Snapshot()
+			tr.Remove("ring")
+
+			got = tr.GetWordsWithSuffix("ing")
+			sort.Strings(got)
+			if expected := []string{"jumping", "walking"}; !reflect.DeepEqual(got, expected) {
+				t.Errorf("after removals GetWordsWithSuffix(ing) = %v; want %v", got, expected)
+			}
+			if tr.EndsWith("un") {
+				t.Errorf("EndsWith(un) should be false after RemovePrefix(ru)")
+			}
+			if !snap.EndsWith("ring") || tr.EndsWith("ring") {
+				t.Errorf("snapshot suffix queries should not observe later removals")
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package trie
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Zubayear/ryushin/internal/periodic"
+	"github.com/Zubayear/ryushin/priorityqueue"
+)
+
+// deadline is an entry of the expiry heap: word expires at the given time.
+//
+// Entries are not removed from the heap when a word is re-inserted or deleted; an
+// entry is stale if it no longer matches the deadline recorded in Trie.expiry.
+type deadline struct {
+	word string
+	at   time.Time
+}
+
+// WithClock makes the Trie use now instead of time.Now to decide whether words
+// inserted with InsertWithTTL have expired. It is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(t *Trie) {
+		t.now = now
+	}
+}
+
+// InsertWithTTL adds a word into the Trie that expires after ttl.
+//
+// Once expired, the word is no longer returned or counted by searches, prefix and
+// suffix queries, iterators or Size, and it is removed from the Trie by the next
+// modifying operation, PurgeExpired or a running janitor (see StartJanitor).
+//
+// Inserting an existing word again refreshes its deadline; a plain Insert of the word
+// makes it permanent. A ttl <= 0 inserts a word that is already expired.
+//
+// Example:
+//
+//	t.InsertWithTTL("golang tutorial", time.Hour) // recent query, suggested for an hour
+//
+// Time Complexity: O(N + log E), where N = length of the word and E = number of words with a TTL
+func (t *Trie) InsertWithTTL(word string, ttl time.Duration) {
+	if len(word) == 0 {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.purgeExpired()
+	t.insert(word)
+	t.setExpiry(word, t.now().Add(ttl))
+}
+
+// TTL returns the time left until word expires.
+//
+// Returns:
+//   - the remaining time and true if word was inserted with a TTL and has not expired
+//   - zero and false if word is absent, expired or permanent
+//
+// Time Complexity: O(1)
+func (t *Trie) TTL(word string) (time.Duration, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	at, ok := t.expiry[word]
+	if !ok {
+		return 0, false
+	}
+	left := at.Sub(t.now())
+	if left <= 0 {
+		return 0, false
+	}
+	return left, true
+}
+
+// PurgeExpired removes every expired word from the Trie and returns how many words
+// were removed.
+//
+// Time Complexity: O(X * (N + log E)), where X = number of expired words
+func (t *Trie) PurgeExpired() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.purgeExpired()
+}
+
+// StartJanitor starts a background goroutine that calls PurgeExpired every interval,
+// so expired words are removed even if the Trie is not modified.
+//
+// It returns a function that stops the goroutine; calling it more than once is safe.
+// interval must be positive; StartJanitor panics otherwise.
+//
+// Example:
+//
+//	stop := t.StartJanitor(time.Minute)
+//	defer stop()
+func (t *Trie) StartJanitor(interval time.Duration) (stop func()) {
+	return periodic.Start(interval, func() { t.PurgeExpired() })
+}
+
+// setExpiry records that word expires at the given time. The caller must hold the write lock.
+//
+// Time Complexity: O(log E)
+func (t *Trie) setExpiry(word string, at time.Time) {
+	if t.expiry == nil {
+		t.expiry = make(map[string]time.Time)
+		t.deadlines = priorityqueue.NewBinaryHeapWithComparator(func(a, b deadline) bool {
+			return a.at.Before(b.at)
+		})
+	}
+	t.expiry[word] = at
+	t.deadlines.Add(deadline{word, at})
+}
+
+// expired reports whether word was inserted with a TTL that has run out.
+// The caller must hold the lock.
+//
+// Time Complexity: O(1)
+func (t *Trie) expired(word string) bool {
+	at, ok := t.expiry[word]
+	return ok && !at.After(t.now())
+}
+
+// live reports whether the stored word has not expired. The caller must hold the lock.
+//
+// Time Complexity: O(1)
+func (t *Trie) live(word string) bool {
+	return len(t.expiry) == 0 || !t.expired(word)
+}
+
+// expiredWithPrefix returns how many stored words start with prefix and have expired
+// but not been removed yet. The caller must hold the lock.
+//
+// Time Complexity: O(E), where E = number of words with a TTL
+func (t *Trie) expiredWithPrefix(prefix string) int {
+	if len(t.expiry) == 0 {
+		return 0
+	}
+	now := t.now()
+	n := 0
+	for word, at := range t.expiry {
+		if !at.After(now) && strings.HasPrefix(word, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+// purgeExpired removes every expired word and returns how many words were removed.
+// The caller must hold the write lock.
+//
+// Algorithm Steps:
+//   - Poll deadlines from the heap while the earliest one has passed.
+//   - Skip stale entries whose word was re-inserted, made permanent or removed.
+//   - Remove the word from the Trie otherwise.
+//
+// Time Complexity: O(1) if nothing expired, O(X * (N + log E)) otherwise
+func (t *Trie) purgeExpired() int {
+	if len(t.expiry) == 0 {
+		if t.deadlines != nil {
+			// only stale entries are left
+			t.deadlines.Clear()
+		}
+		return 0
+	}
+	now := t.now()
+	removed := 0
+	for {
+		d, ok := t.deadlines.PollIf(func(d deadline) bool { return !d.at.After(now) })
+		if !ok {
+			break
+		}
+		if at, ok := t.expiry[d.word]; !ok || !at.Equal(d.at) {
+			continue
+		}
+		if t.remove(d.word) {
+			removed++
+		}
+	}
+	return removed
+}
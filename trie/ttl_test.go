@@ -0,0 +1,198 @@
+package trie
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced clock for TTL tests.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestTrieInsertWithTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	tr := NewTrie(WithClock(clock.Now))
+	tr.Insert("go")
+	tr.InsertWithTTL("gopher", time.Minute)
+	tr.InsertWithTTL("golang", 2*time.Minute)
+
+	if !tr.Search("gopher") || tr.Size() != 3 {
+		t.Fatalf("expected live gopher and size 3")
+	}
+	if left, ok := tr.TTL("gopher"); !ok || left != time.Minute {
+		t.Errorf("TTL(gopher) = %v, %v; want 1m, true", left, ok)
+	}
+	if _, ok := tr.TTL("go"); ok {
+		t.Errorf("permanent word should not report a TTL")
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	if tr.Search("gopher") || tr.SearchBytes([]byte("gopher")) {
+		t.Errorf("expired word should not be found")
+	}
+	if !tr.Search("golang") {
+		t.Errorf("golang should still be live")
+	}
+
+	// the next modification purges expired words lazily
+	tr.Insert("gone")
+	got := tr.GetWordsWithPrefix("go")
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"go", "golang", "gone"}) {
+		t.Errorf("words after lazy purge = %v", got)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	if n := tr.PurgeExpired(); n != 1 {
+		t.Errorf("PurgeExpired() = %d; want 1", n)
+	}
+	if tr.Size() != 2 || tr.PurgeExpired() != 0 {
+		t.Errorf("unexpected state after purge, size %d", tr.Size())
+	}
+}
+
+func TestTrieSearchFuzzySkipsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	tr := NewTrie(WithClock(clock.Now))
+	tr.Insert("cart")
+	tr.InsertWithTTL("card", time.Minute)
+	tr.InsertWithTTL("care", 2*time.Minute)
+
+	clock.now = clock.now.Add(time.Minute)
+	got := tr.SearchFuzzy("carx", 1)
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"care", "cart"}) {
+		t.Errorf("SearchFuzzy(carx, 1) = %v; want [care cart]", got)
+	}
+}
+
+func TestTrieTTLRefreshAndPermanent(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	tr := NewTrie(WithClock(clock.Now))
+	tr.InsertWithTTL("refresh", time.Minute)
+	tr.InsertWithTTL("keep", time.Minute)
+	tr.InsertWithTTL("drop", time.Minute)
+
+	clock.now = clock.now.Add(30 * time.Second)
+	tr.InsertWithTTL("refresh", time.Minute)
+	tr.Insert("keep")
+	tr.Remove("drop")
+
+	clock.now = clock.now.Add(45 * time.Second)
+	if n := tr.PurgeExpired(); n != 0 {
+		t.Errorf("stale deadlines should be ignored, purged %d", n)
+	}
+	if !tr.Search("refresh") || !tr.Search("keep") || tr.Search("drop") {
+		t.Errorf("unexpected words after refresh")
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	if n := tr.PurgeExpired(); n != 1 || !tr.Search("keep") {
+		t.Errorf("expected only refresh to expire, purged %d", n)
+	}
+
+	tr.InsertWithTTL("prefix-a", time.Minute)
+	if tr.RemovePrefix("prefix") != 1 {
+		t.Errorf("RemovePrefix should remove words with a TTL")
+	}
+	tr.Insert("prefix-a")
+	clock.now = clock.now.Add(time.Hour)
+	if tr.PurgeExpired() != 0 || !tr.Search("prefix-a") {
+		t.Errorf("re-inserted word should be permanent")
+	}
+}
+
+func TestTrieTTLSnapshot(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	tr := NewTrie(WithClock(clock.Now))
+	tr.InsertWithTTL("temp", time.Minute)
+	snap := tr.Snapshot()
+
+	clock.now = clock.now.Add(time.Minute)
+	if snap.Search("temp") || snap.PurgeExpired() != 1 {
+		t.Errorf("words should keep expiring in the snapshot")
+	}
+	// Size already ignores the expired word, so PurgeExpired shows it is still stored
+	if tr.Size() != 0 || tr.PurgeExpired() != 1 || !tr.IsEmpty() {
+		t.Errorf("purging the snapshot should not affect the trie")
+	}
+}
+
+func TestTrieQueriesSkipExpired(t *testing.T) {
+	for _, suffixIndex := range []bool{false, true} {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		opts := []Option{WithClock(clock.Now)}
+		if suffixIndex {
+			opts = append(opts, WithSuffixIndex())
+		}
+		tr := NewTrie(opts...)
+		tr.Insert("gopher")
+		tr.InsertWithTTL("golang", time.Minute)
+		tr.InsertWithTTL("rust", time.Minute)
+		clock.now = clock.now.Add(time.Minute)
+
+		want := []string{"gopher"}
+		sorted := func(words []string) []string {
+			sort.Strings(words)
+			return words
+		}
+		collect := func(seq func(func(string) bool)) []string {
+			var words []string
+			for w := range seq {
+				words = append(words, w)
+			}
+			return sorted(words)
+		}
+		checks := []struct {
+			name string
+			got  []string
+		}{
+			{"GetWordsWithPrefix", sorted(tr.GetWordsWithPrefix("go"))},
+			{"GetWordsWithPrefixSorted", tr.GetWordsWithPrefixSorted("go")},
+			{"GetWordsWithPrefixN", tr.GetWordsWithPrefixN("go", 10, "")},
+			{"GetWordsWithPrefixBytes", sorted(tr.GetWordsWithPrefixBytes([]byte("go")))},
+			{"Words", collect(tr.Words())},
+			{"WordsWithPrefix", collect(tr.WordsWithPrefix("go"))},
+			{"GetWordsWithSuffix", tr.GetWordsWithSuffix("er")},
+		}
+		for _, c := range checks {
+			if !reflect.DeepEqual(c.got, want) {
+				t.Errorf("suffix index %v: %s = %v; want %v", suffixIndex, c.name, c.got, want)
+			}
+		}
+		if got := tr.GetWordsWithSuffix("ng"); len(got) != 0 {
+			t.Errorf("suffix index %v: GetWordsWithSuffix(ng) = %v; want none", suffixIndex, got)
+		}
+		if n := tr.CountWordsWithPrefix("go"); n != 1 {
+			t.Errorf("suffix index %v: CountWordsWithPrefix(go) = %d; want 1", suffixIndex, n)
+		}
+		if tr.StartsWith("gol") || tr.StartsWithBytes([]byte("ru")) || !tr.StartsWith("go") {
+			t.Errorf("suffix index %v: StartsWith should only see live words", suffixIndex)
+		}
+		if tr.EndsWith("ng") || tr.EndsWith("st") || !tr.EndsWith("her") {
+			t.Errorf("suffix index %v: EndsWith should only see live words", suffixIndex)
+		}
+		if tr.Size() != 1 || tr.IsEmpty() {
+			t.Errorf("suffix index %v: Size() = %d; want 1", suffixIndex, tr.Size())
+		}
+	}
+}
+
+func TestTrieStartJanitor(t *testing.T) {
+	tr := NewTrie()
+	tr.InsertWithTTL("flash", time.Millisecond)
+	stop := tr.StartJanitor(time.Millisecond)
+	defer stop()
+
+	deadline := time.Now().Add(time.Second)
+	for !tr.IsEmpty() {
+		if time.Now().After(deadline) {
+			t.Fatalf("janitor did not purge expired word")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+}